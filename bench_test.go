@@ -40,3 +40,74 @@ func BenchmarkDecode(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkRead measures decoding frames in the steady state, which must not allocate.
+func BenchmarkRead(b *testing.B) {
+	buf, err := ioutil.ReadFile("example/classic.mp3")
+	if err != nil {
+		b.Fatal(err)
+	}
+	d, err := NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := make([]byte, maxBytesPerFrame)
+	read := func() {
+		if _, err := d.Read(p); err == io.EOF {
+			if _, err := d.Seek(0, io.SeekStart); err != nil {
+				b.Fatal(err)
+			}
+		} else if err != nil {
+			b.Fatal(err)
+		}
+	}
+	// Warm up.
+	read()
+	if n := testing.AllocsPerRun(10, read); n > 0 {
+		b.Fatalf("allocations per Read: got: %v, want: 0", n)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		read()
+	}
+}
+
+// BenchmarkDecodeScratch measures decoding whole streams with a reused Scratch and without keeping the PCM.
+func BenchmarkDecodeScratch(b *testing.B) {
+	buf, err := ioutil.ReadFile("example/classic.mp3")
+	if err != nil {
+		b.Fatal(err)
+	}
+	src := bytes.NewReader(buf)
+	scratch := NewScratch()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			b.Fatal(err)
+		}
+		d, err := NewDecoderWithOptions(src, &DecoderOptions{Scratch: scratch})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := io.Copy(ioutil.Discard, d); err != nil {
+			b.Fatal(err)
+		}
+		scratch = d.Scratch()
+	}
+}
+
+func BenchmarkNewDecoder(b *testing.B) {
+	buf, err := ioutil.ReadFile("example/classic.mp3")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		// NewDecoder scans the whole stream for the length.
+		if _, err := NewDecoder(bytes.NewReader(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
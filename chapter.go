@@ -0,0 +1,153 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3/internal/id3"
+)
+
+// A Chapter represents a chapter defined by an ID3v2 CHAP frame.
+type Chapter struct {
+	// ID is the element ID of the chapter.
+	ID string
+
+	// Title is the title of the chapter from the embedded TIT2 frame. Title is empty if there is no title.
+	Title string
+
+	// URL is the URL of the chapter from the embedded WXXX or other URL link frame. URL is empty if there is no URL.
+	URL string
+
+	// Start and End are the time range of the chapter.
+	Start time.Duration
+	End   time.Duration
+}
+
+// Chapters returns the chapters in the ID3v2 tag at the beginning of the stream.
+//
+// If the tag has a top-level ordered table of contents (CTOC frame), the chapters are in the order of the table of
+// contents, including the nested tables of contents. The chapters not in the table of contents follow. Otherwise,
+// the chapters are sorted by the start time.
+//
+// Chapters returns nil if there are no chapters.
+func (d *Decoder) Chapters() []Chapter {
+	if d.source.id3v2 == nil {
+		return nil
+	}
+	tag, err := id3.Parse(d.source.id3v2)
+	if err != nil {
+		return nil
+	}
+
+	var chapters []Chapter
+	tocs := map[string]*id3.TableOfContents{}
+	var root *id3.TableOfContents
+	for _, f := range tag.Frames {
+		switch f.ID {
+		case "CHAP":
+			c, err := id3.ParseChapter(f.Data, tag.MajorVersion)
+			if err != nil {
+				continue
+			}
+			chapters = append(chapters, newChapter(c))
+		case "CTOC":
+			t, err := id3.ParseTableOfContents(f.Data, tag.MajorVersion)
+			if err != nil {
+				continue
+			}
+			tocs[t.ElementID] = t
+			if t.TopLevel && root == nil {
+				root = t
+			}
+		}
+	}
+
+	// order is the position of each chapter in the table of contents.
+	order := map[string]int{}
+	if root != nil && root.Ordered {
+		var visit func(t *id3.TableOfContents)
+		visit = func(t *id3.TableOfContents) {
+			for _, id := range t.ChildIDs {
+				if c, ok := tocs[id]; ok {
+					// Avoid infinite recursion in broken tags.
+					delete(tocs, id)
+					visit(c)
+					continue
+				}
+				if _, ok := order[id]; !ok {
+					order[id] = len(order)
+				}
+			}
+		}
+		delete(tocs, root.ElementID)
+		visit(root)
+	}
+	rank := func(c Chapter) int {
+		if i, ok := order[c.ID]; ok {
+			return i
+		}
+		return len(order)
+	}
+	sort.SliceStable(chapters, func(i, j int) bool {
+		if ri, rj := rank(chapters[i]), rank(chapters[j]); ri != rj {
+			return ri < rj
+		}
+		return chapters[i].Start < chapters[j].Start
+	})
+	return chapters
+}
+
+func newChapter(c *id3.Chapter) Chapter {
+	ch := Chapter{
+		ID:    c.ElementID,
+		Start: time.Duration(c.StartTime) * time.Millisecond,
+		End:   time.Duration(c.EndTime) * time.Millisecond,
+	}
+	for _, f := range c.Frames {
+		switch {
+		case f.ID == "TIT2":
+			if ch.Title == "" {
+				ch.Title = id3.Text(f.Data)
+			}
+		case strings.HasPrefix(f.ID, "W"):
+			if ch.URL == "" {
+				ch.URL = id3.URL(f.ID, f.Data)
+			}
+		}
+	}
+	return ch
+}
+
+// SeekChapter seeks to the start of the i-th chapter of Chapters, and returns the new offset in bytes.
+func (d *Decoder) SeekChapter(i int) (int64, error) {
+	chapters := d.Chapters()
+	if i < 0 || i >= len(chapters) {
+		return 0, fmt.Errorf("mp3: chapter index out of range: %d", i)
+	}
+	return d.Seek(d.timeToOffset(chapters[i].Start), io.SeekStart)
+}
+
+// timeToOffset converts the time to the byte offset of the decoded stream, aligned to a sample.
+func (d *Decoder) timeToOffset(t time.Duration) int64 {
+	return int64(t) * int64(d.sampleRate) / int64(time.Second) * 4
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func syncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7f), byte(n >> 14 & 0x7f), byte(n >> 7 & 0x7f), byte(n & 0x7f)}
+}
+
+// id3v24Frame returns an ID3v2.4 frame.
+func id3v24Frame(id string, data []byte) []byte {
+	b := append([]byte(id), syncsafe(len(data))...)
+	b = append(b, 0, 0)
+	return append(b, data...)
+}
+
+// id3v24Tag returns an ID3v2.4 tag with the frames.
+func id3v24Tag(frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	b := append([]byte("ID3\x04\x00\x00"), syncsafe(len(body))...)
+	return append(b, body...)
+}
+
+// chapFrame returns a CHAP frame with a TIT2 frame and the extra embedded frames.
+func chapFrame(id string, start, end time.Duration, title string, extra ...[]byte) []byte {
+	var b []byte
+	b = append(b, id...)
+	b = append(b, 0)
+	var times [16]byte
+	binary.BigEndian.PutUint32(times[0:], uint32(start/time.Millisecond))
+	binary.BigEndian.PutUint32(times[4:], uint32(end/time.Millisecond))
+	binary.BigEndian.PutUint32(times[8:], 0xffffffff)
+	binary.BigEndian.PutUint32(times[12:], 0xffffffff)
+	b = append(b, times[:]...)
+	b = append(b, id3v24Frame("TIT2", append([]byte{3}, title...))...)
+	for _, f := range extra {
+		b = append(b, f...)
+	}
+	return id3v24Frame("CHAP", b)
+}
+
+// ctocFrame returns a CTOC frame with the flags and the child element IDs.
+func ctocFrame(id string, flags byte, children ...string) []byte {
+	var b []byte
+	b = append(b, id...)
+	b = append(b, 0, flags, byte(len(children)))
+	for _, c := range children {
+		b = append(b, c...)
+		b = append(b, 0)
+	}
+	return id3v24Frame("CTOC", b)
+}
+
+func TestChapters(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag := id3v24Tag(
+		chapFrame("ch1", 10*time.Second, 30*time.Second, "Second"),
+		chapFrame("ch0", 0, 10*time.Second, "First"),
+	)
+	d, err := mp3.NewDecoder(bytes.NewReader(append(tag, buf...)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chapters := d.Chapters()
+	wantChapters := []mp3.Chapter{
+		{ID: "ch0", Title: "First", Start: 0, End: 10 * time.Second},
+		{ID: "ch1", Title: "Second", Start: 10 * time.Second, End: 30 * time.Second},
+	}
+	if len(chapters) != len(wantChapters) {
+		t.Fatalf("Chapters: got: %v, want: %v", chapters, wantChapters)
+	}
+	for i := range chapters {
+		if chapters[i] != wantChapters[i] {
+			t.Errorf("Chapters()[%d]: got: %v, want: %v", i, chapters[i], wantChapters[i])
+		}
+	}
+
+	pos, err := d.SeekChapter(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pos, int64(10*d.SampleRate()*4); got != want {
+		t.Errorf("SeekChapter: got: %d, want: %d", got, want)
+	}
+	got := make([]byte, 4096)
+	if _, err := io.ReadFull(d, got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want[pos:pos+int64(len(got))]) {
+		t.Errorf("decoded PCM after SeekChapter doesn't match")
+	}
+	if _, err := d.SeekChapter(2); err == nil {
+		t.Errorf("SeekChapter(2) must fail")
+	}
+}
+
+func TestChaptersTableOfContents(t *testing.T) {
+	url := id3v24Frame("WXXX", []byte("\x03desc\x00https://example.com/ch1"))
+	tag := id3v24Tag(
+		ctocFrame("toc", 0x03, "ch2", "sub"),
+		ctocFrame("sub", 0x01, "ch1"),
+		chapFrame("ch0", 0, 10*time.Second, "First"),
+		chapFrame("ch1", 10*time.Second, 20*time.Second, "Second", url),
+		chapFrame("ch2", 20*time.Second, 30*time.Second, "Third"),
+	)
+	d, err := mp3.NewDecoder(bytes.NewReader(append(tag, mustReadFile(t, "example/mpeg2.mp3")...)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The chapters in the table of contents come first in its order.
+	chapters := d.Chapters()
+	wantChapters := []mp3.Chapter{
+		{ID: "ch2", Title: "Third", Start: 20 * time.Second, End: 30 * time.Second},
+		{ID: "ch1", Title: "Second", URL: "https://example.com/ch1", Start: 10 * time.Second, End: 20 * time.Second},
+		{ID: "ch0", Title: "First", Start: 0, End: 10 * time.Second},
+	}
+	if len(chapters) != len(wantChapters) {
+		t.Fatalf("Chapters: got: %v, want: %v", chapters, wantChapters)
+	}
+	for i := range chapters {
+		if chapters[i] != wantChapters[i] {
+			t.Errorf("Chapters()[%d]: got: %v, want: %v", i, chapters[i], wantChapters[i])
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"hash/crc32"
+)
+
+// notifyFrameChecksum calls OnFrameChecksum with the checksum of the PCM of the frame at offset.
+func (d *Decoder) notifyFrameChecksum(offset int64, pcm []byte) {
+	if d.onFrameChecksum != nil {
+		d.onFrameChecksum(offset, crc32.ChecksumIEEE(pcm))
+	}
+}
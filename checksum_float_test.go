@@ -0,0 +1,21 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3fixed
+// +build !mp3fixed
+
+package mp3_test
+
+// outputChecksum is the CRC-32 of the decoded example/mpeg2.mp3.
+const outputChecksum uint32 = 0xc8703ca2
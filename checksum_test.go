@@ -0,0 +1,55 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestFrameChecksum(t *testing.T) {
+	var offsets []int64
+	var checksums []uint32
+	got := decodeAll(t, "example/mpeg2.mp3", &mp3.DecoderOptions{
+		OnFrameChecksum: func(offset int64, checksum uint32) {
+			offsets = append(offsets, offset)
+			checksums = append(checksums, checksum)
+		},
+	})
+
+	if len(checksums) != mpeg2Frames {
+		t.Fatalf("the number of checksums: got: %d, want: %d", len(checksums), mpeg2Frames)
+	}
+	frames := splitMPEG2Frames(t)
+	offset := int64(mpeg2AudioStart)
+	// MPEG-2 has 576 samples per frame.
+	const frameSize = 576 * 4
+	for i, c := range checksums {
+		if offsets[i] != offset {
+			t.Errorf("offset of frame %d: got: %d, want: %d", i, offsets[i], offset)
+		}
+		if want := crc32.ChecksumIEEE(got[i*frameSize : (i+1)*frameSize]); c != want {
+			t.Errorf("checksum of frame %d: got: %08x, want: %08x", i, c, want)
+		}
+		if i < len(frames) {
+			offset += int64(len(frames[i]))
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"errors"
+	"io"
+)
+
+// Clone returns a new decoder that reads the same stream independently.
+//
+// The cloned decoder shares the frame index with d, so the stream is not scanned again.
+// The cloned decoder starts at the same position as d.
+//
+// Clone requires the source to be both io.ReaderAt and io.Seeker, like *os.File or *bytes.Reader.
+// The cloned decoder reads the source only via ReadAt, so d and the cloned decoder don't disturb each other.
+// They can be used on different goroutines as long as the source's ReadAt is safe for concurrent use.
+func (d *Decoder) Clone() (*Decoder, error) {
+	r, ok := d.source.reader.(interface {
+		io.ReaderAt
+		io.Seeker
+	})
+	if !ok {
+		return nil, errors.New("mp3: Clone requires the source to be io.ReaderAt and io.Seeker")
+	}
+	if err := d.waitScan(); err != nil {
+		return nil, err
+	}
+	size, err := sourceSize(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.clone(r, size)
+}
+
+// clone returns a new decoder that reads r of size bytes, which is the source of d, via ReadAt.
+// clone doesn't change r's state, so clone can be called on different goroutines concurrently.
+// The background scan of d must be finished.
+func (d *Decoder) clone(r io.ReaderAt, size int64) (*Decoder, error) {
+	c := &Decoder{
+		source: &source{
+			reader:   io.NewSectionReader(r, 0, size),
+			id3v2:    d.source.id3v2,
+			tags:     d.source.tags,
+			trailing: d.trailingTags(),
+			end:      d.audioEnd(),
+
+			headerOptions: d.source.headerOptions,
+		},
+		sampleRate:  d.sampleRate,
+		length:      d.length,
+		frameStarts: d.frameStarts,
+		frameSizes:  d.frameSizes,
+		seekMode:    d.seekMode,
+		quality:     d.quality,
+		onGap:       d.onGap,
+		info:        d.info,
+		first:       d.first,
+
+		segment:         d.segment,
+		parameterChange: d.parameterChange,
+		onSegment:       d.onSegment,
+		crcMismatch:     d.crcMismatch,
+		frameError:      d.frameError,
+		strict:          d.strict,
+		partial:         d.partial,
+		memoryLimit:     d.memoryLimit,
+		onWarning:       d.onWarning,
+		onCRCError:      d.onCRCError,
+		onResync:        d.onResync,
+		onFrameChecksum: d.onFrameChecksum,
+		framesPerRead:   d.framesPerRead,
+		ctx:             d.ctx,
+	}
+	if d.normalizer != nil {
+		n := *d.normalizer
+		if n.meter != nil {
+			m := *n.meter
+			n.meter = &m
+		}
+		c.normalizer = &n
+	}
+	if _, err := c.Seek(d.outputPosition(d.pos), io.SeekStart); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+// mp3check checks the integrity of MP3 files with mp3.Verify, e.g. to find corrupted files in a music library.
+//
+// Usage:
+//
+//	mp3check [-j jobs] [-all] [-ext extensions] path ...
+//
+// Directories are walked recursively for the files with the extensions. The files specified explicitly are checked
+// regardless of their extensions. The files are checked concurrently.
+//
+// The report is written to stdout in JSON Lines: a JSON object per file in the order of the paths. By default, only
+// the files with problems are reported. The exit status is 1 if any file has a problem.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// A fileReport is the report of a file.
+type fileReport struct {
+	Path string `json:"path"`
+	OK   bool   `json:"ok"`
+
+	// Error and ErrorKind are set when the file can't be checked at all, e.g. when it is not an MP3 file.
+	Error     string `json:"error,omitempty"`
+	ErrorKind string `json:"errorKind,omitempty"`
+
+	Frames              int64      `json:"frames"`
+	BadFrames           []badFrame `json:"badFrames,omitempty"`
+	CRCErrors           int64      `json:"crcErrors,omitempty"`
+	ReservoirUnderflows int64      `json:"reservoirUnderflows,omitempty"`
+	SkippedBytes        int64      `json:"skippedBytes,omitempty"`
+	Truncated           bool       `json:"truncated,omitempty"`
+}
+
+// A badFrame is a frame that can't be decoded.
+type badFrame struct {
+	Offset int64  `json:"offset"`
+	Kind   string `json:"kind"`
+	Error  string `json:"error"`
+}
+
+// errorKinds is the kinds of the errors reported in the errorKind and kind fields. Other errors, e.g. I/O errors,
+// are "other".
+var errorKinds = []struct {
+	err  error
+	kind string
+}{
+	{mp3.ErrUnsupportedLayer, "unsupportedLayer"},
+	{mp3.ErrFreeBitrate, "freeBitrate"},
+	{mp3.ErrInvalidHeader, "invalidHeader"},
+	{mp3.ErrInvalidSideInfo, "invalidSideInfo"},
+	{mp3.ErrInvalidMainData, "invalidMainData"},
+	{mp3.ErrTruncated, "truncated"},
+	{mp3.ErrNoSync, "noSync"},
+	{mp3.ErrMemoryLimit, "memoryLimit"},
+	{mp3.ErrNoValidFrames, "noValidFrames"},
+}
+
+func errorKind(err error) string {
+	for _, k := range errorKinds {
+		if errors.Is(err, k.err) {
+			return k.kind
+		}
+	}
+	return "other"
+}
+
+func main() {
+	ok, err := run(os.Args[1:], os.Stdout, os.Stderr)
+	if err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, "mp3check:", err)
+		}
+		os.Exit(2)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+// run checks the files and reports whether all the files are OK.
+func run(args []string, stdout, stderr io.Writer) (bool, error) {
+	flags := flag.NewFlagSet("mp3check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: mp3check [-j jobs] [-all] [-ext extensions] path ...")
+		flags.PrintDefaults()
+	}
+	jobs := flags.Int("j", runtime.NumCPU(), "number of files checked concurrently")
+	all := flags.Bool("all", false, "report all the files including the files without problems")
+	exts := flags.String("ext", ".mp3", "comma-separated extensions of the files checked in directories")
+	if err := flags.Parse(args); err != nil {
+		return false, err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return false, flag.ErrHelp
+	}
+	if *jobs < 1 {
+		*jobs = 1
+	}
+
+	paths, err := collect(flags.Args(), strings.Split(strings.ToLower(*exts), ","))
+	if err != nil {
+		return false, err
+	}
+
+	reports := make([]chan *fileReport, len(paths))
+	for i := range reports {
+		reports[i] = make(chan *fileReport, 1)
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < *jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				reports[i] <- check(paths[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range paths {
+			indices <- i
+		}
+		close(indices)
+	}()
+
+	// Write the reports in the order of the paths as they are finished.
+	ok := true
+	e := json.NewEncoder(stdout)
+	var werr error
+	for _, c := range reports {
+		r := <-c
+		if !r.OK {
+			ok = false
+		}
+		if werr != nil || (r.OK && !*all) {
+			continue
+		}
+		werr = e.Encode(r)
+	}
+	wg.Wait()
+	return ok, werr
+}
+
+// collect returns the files of paths, walking directories for the files with the extensions.
+func collect(paths []string, exts []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		if err := filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			ext := strings.ToLower(filepath.Ext(path))
+			for _, e := range exts {
+				if ext == e {
+					files = append(files, path)
+					break
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// check checks the file at path.
+func check(path string) (report *fileReport) {
+	report = &fileReport{
+		Path: path,
+	}
+	// A panic is reported instead of aborting the whole check.
+	defer func() {
+		if r := recover(); r != nil {
+			report.OK = false
+			report.Error = fmt.Sprintf("panic: %v", r)
+			report.ErrorKind = "panic"
+		}
+	}()
+
+	f, err := os.Open(path)
+	if err != nil {
+		report.Error = err.Error()
+		report.ErrorKind = errorKind(err)
+		return report
+	}
+	defer f.Close()
+
+	r, err := mp3.Verify(f)
+	if err != nil {
+		report.Error = err.Error()
+		report.ErrorKind = errorKind(err)
+		return report
+	}
+	report.OK = r.OK()
+	report.Frames = r.Frames
+	if r.Frames == 0 {
+		// Verify might find no frames without an error, e.g. in a file of garbage.
+		report.OK = false
+		report.Error = mp3.ErrNoValidFrames.Error()
+		report.ErrorKind = errorKind(mp3.ErrNoValidFrames)
+	}
+	for _, b := range r.BadFrames {
+		report.BadFrames = append(report.BadFrames, badFrame{
+			Offset: b.Offset,
+			Kind:   errorKind(b.Err),
+			Error:  b.Err.Error(),
+		})
+	}
+	report.CRCErrors = r.CRCErrors
+	report.ReservoirUnderflows = r.ReservoirUnderflows
+	report.SkippedBytes = r.SkippedBytes
+	report.Truncated = r.Truncated
+	return report
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	src, err := ioutil.ReadFile("../../example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Corrupt the side information of a frame in the middle. The audio starts after the 45-byte ID3v2 tag.
+	corrupted := append([]byte{}, src...)
+	i := bytes.Index(corrupted[len(src)/2:], src[45:49]) + len(src)/2
+	// big_values of the first granule is at bits 21-29 of the side information.
+	corrupted[i+6] |= 0x07
+	corrupted[i+7] |= 0xfc
+
+	dir, err := ioutil.TempDir("", "mp3check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, content := range map[string][]byte{
+		"good.mp3":          src,
+		"sub/corrupted.mp3": corrupted,
+		"sub/truncated.MP3": src[:len(src)-100],
+		"sub/garbage.mp3":   bytes.Repeat([]byte("garbage"), 100),
+		"sub/notes.txt":     []byte("not checked"),
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	ok, err := run([]string{"-j", "2", "-all", dir}, out, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Errorf("run must report problems")
+	}
+
+	var reports []*fileReport
+	s := bufio.NewScanner(out)
+	for s.Scan() {
+		var r fileReport
+		if err := json.Unmarshal(s.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		reports = append(reports, &r)
+	}
+	// The reports are in the order of the paths.
+	names := []string{"good.mp3", "sub/corrupted.mp3", "sub/garbage.mp3", "sub/truncated.MP3"}
+	if len(reports) != len(names) {
+		t.Fatalf("got %d reports, want %d", len(reports), len(names))
+	}
+	for i, name := range names {
+		if got, want := reports[i].Path, filepath.Join(dir, name); got != want {
+			t.Errorf("reports[%d].Path: got: %s, want: %s", i, got, want)
+		}
+	}
+
+	if r := reports[0]; !r.OK || r.Frames == 0 {
+		t.Errorf("good.mp3: got: %+v, want: no problem", r)
+	}
+	if r := reports[1]; r.OK || len(r.BadFrames) != 1 || r.BadFrames[0].Kind != "invalidSideInfo" || r.BadFrames[0].Offset != int64(i) {
+		t.Errorf("corrupted.mp3: got: %+v, want: a frame with invalid side information at %d", r, i)
+	}
+	if r := reports[2]; r.OK || r.ErrorKind != "noValidFrames" {
+		t.Errorf("garbage.mp3: got: %+v, want: no valid frames", r)
+	}
+	if r := reports[3]; r.OK || !r.Truncated {
+		t.Errorf("truncated.MP3: got: %+v, want: truncated", r)
+	}
+
+	// Only the files with problems are reported by default.
+	out.Reset()
+	if _, err := run([]string{filepath.Join(dir, "good.mp3"), filepath.Join(dir, "sub", "truncated.MP3")}, out, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if got := bytes.Count(out.Bytes(), []byte("\n")); got != 1 {
+		t.Errorf("got %d reports, want 1", got)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+// mp3cut cuts a time range of an MP3 file without re-encoding.
+//
+// Usage:
+//
+//	mp3cut [-start duration] [-end duration] -o output file
+//
+// The durations are like "1m30s" or "1.5s". Without -end, the range lasts to the end of the file. The output file
+// has the frames covering the range with an Info or Xing frame whose LAME tag trims the output to the exact range on
+// gapless decoders. The tags of the input file are not copied. The input "-" is stdin, which must be a regular file,
+// and the output "-" is stdout.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func main() {
+	var stdin io.Reader = os.Stdin
+	// A pipe can't seek. Hide the Seek method so that the error is reported clearly.
+	if !isRegular(os.Stdin) {
+		stdin = struct{ io.Reader }{os.Stdin}
+	}
+	if err := run(os.Args[1:], stdin, os.Stdout, os.Stderr); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, "mp3cut:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// isRegular reports whether f is a regular file.
+func isRegular(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode().IsRegular()
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("mp3cut", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: mp3cut [-start duration] [-end duration] -o output file")
+		flags.PrintDefaults()
+	}
+	start := flags.Duration("start", 0, "start of the range")
+	end := flags.Duration("end", 0, "end of the range (0 for the end of the file)")
+	output := flags.String("o", "", `output file ("-" for stdout)`)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("exactly one input file is required")
+	}
+	if *output == "" {
+		return errors.New("-o is required")
+	}
+	return cut(flags.Arg(0), *output, *start, *end, stdin, stdout)
+}
+
+// cut cuts the range of the MP3 file in to the MP3 file out. "-" means stdin or stdout.
+// The output file is removed on failure.
+func cut(in, out string, start, end time.Duration, stdin io.Reader, stdout io.Writer) (err error) {
+	name := in
+	r, ok := stdin.(io.ReadSeeker)
+	if in == "-" {
+		name = "stdin"
+		if !ok {
+			return errors.New("stdin can't seek; specify a file")
+		}
+	} else {
+		f, err := os.Open(in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	w := stdout
+	if out != "-" {
+		if in != "-" && sameFile(in, out) {
+			return fmt.Errorf("%s: the output would overwrite the input", in)
+		}
+		f, cerr := os.Create(out)
+		if cerr != nil {
+			return cerr
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(out)
+			}
+		}()
+		w = f
+	}
+
+	if err := mp3.Cut(w, r, start, end); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// sameFile reports whether the paths a and b refer to the same existing file.
+func sameFile(a, b string) bool {
+	fa, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	fb, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(fa, fb)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestRun(t *testing.T) {
+	src, err := ioutil.ReadFile("../../example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &bytes.Buffer{}
+	if err := mp3.Cut(want, bytes.NewReader(src), 1500*time.Millisecond, 3*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "mp3cut")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "in.mp3")
+	if err := ioutil.WriteFile(in, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "out.mp3")
+	if err := run([]string{"-start", "1.5s", "-end", "3s", "-o", out, in}, nil, nil, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want.Bytes()) {
+		t.Errorf("out.mp3 doesn't match")
+	}
+
+	// A seekable stdin is cut to stdout.
+	stdout := &bytes.Buffer{}
+	if err := run([]string{"-start", "1.5s", "-end", "3s", "-o", "-", "-"}, bytes.NewReader(src), stdout, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stdout.Bytes(), want.Bytes()) {
+		t.Errorf("stdout doesn't match")
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mp3cut")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "in.mp3")
+	if err := ioutil.WriteFile(in, []byte("not an MP3 file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "out.mp3")
+
+	for _, args := range [][]string{
+		{in},
+		{"-o", out},
+		{"-o", in, in},
+		{"-o", "-", "-"},
+		{"-o", out, in},
+	} {
+		stdin := struct{ io.Reader }{bytes.NewReader(nil)}
+		if err := run(args, stdin, ioutil.Discard, ioutil.Discard); err == nil {
+			t.Errorf("run(%q) must fail", args)
+		}
+	}
+	// The output is removed on failure.
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Errorf("the output must be removed on failure: %v", err)
+	}
+}
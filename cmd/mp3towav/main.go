@@ -0,0 +1,154 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+// mp3towav converts MP3 files to WAV files of 16-bit stereo PCM.
+//
+// Usage:
+//
+//	mp3towav [-o output] [file ...]
+//
+// Each file is converted to a WAV file next to it, whose extension is replaced with .wav. Without files, or with
+// "-", the MP3 stream is read from stdin and the WAV file is written to stdout. -o specifies the output file of a
+// single input, where "-" is stdout.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func main() {
+	var stdin io.Reader = os.Stdin
+	var stdout io.Writer = os.Stdout
+	// A pipe can't seek. Hide the Seek method so that the stream is processed without seeking.
+	if !isRegular(os.Stdin) {
+		stdin = struct{ io.Reader }{os.Stdin}
+	}
+	if !isRegular(os.Stdout) {
+		stdout = struct{ io.Writer }{os.Stdout}
+	}
+	if err := run(os.Args[1:], stdin, stdout, os.Stderr); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, "mp3towav:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// isRegular reports whether f is a regular file.
+func isRegular(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode().IsRegular()
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("mp3towav", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: mp3towav [-o output] [file ...]")
+		flags.PrintDefaults()
+	}
+	output := flags.String("o", "", `output file ("-" for stdout); only for a single input`)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	inputs := flags.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	if *output != "" && len(inputs) > 1 {
+		return errors.New("-o can't be used with multiple inputs")
+	}
+
+	for _, in := range inputs {
+		out := *output
+		if out == "" {
+			if in == "-" {
+				out = "-"
+			} else {
+				out = strings.TrimSuffix(in, filepath.Ext(in)) + ".wav"
+			}
+		}
+		if err := convert(in, out, stdin, stdout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convert converts the MP3 file in to the WAV file out. "-" means stdin or stdout.
+// The output file is removed on failure.
+func convert(in, out string, stdin io.Reader, stdout io.Writer) (err error) {
+	r := stdin
+	if in != "-" {
+		f, err := os.Open(in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	w := stdout
+	if out != "-" {
+		if in != "-" && sameFile(in, out) {
+			return fmt.Errorf("%s: the output would overwrite the input", in)
+		}
+		f, cerr := os.Create(out)
+		if cerr != nil {
+			return cerr
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(out)
+			}
+		}()
+		w = f
+	}
+
+	if err := mp3.ConvertToWAV(r, w); err != nil {
+		if in == "-" {
+			in = "stdin"
+		}
+		return fmt.Errorf("%s: %w", in, err)
+	}
+	return nil
+}
+
+// sameFile reports whether the paths a and b refer to the same existing file.
+func sameFile(a, b string) bool {
+	fa, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	fb, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(fa, fb)
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestRun(t *testing.T) {
+	src, err := ioutil.ReadFile("../../example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &bytes.Buffer{}
+	if err := mp3.ConvertToWAV(bytes.NewReader(src), want); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "mp3towav")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "in.mp3")
+	if err := ioutil.WriteFile(in, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A file is converted next to it.
+	if err := run([]string{in}, nil, nil, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(dir, "in.wav"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want.Bytes()) {
+		t.Errorf("in.wav doesn't match")
+	}
+
+	// -o specifies the output file.
+	out := filepath.Join(dir, "out.wav")
+	if err := run([]string{"-o", out, in}, nil, nil, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want.Bytes()) {
+		t.Errorf("out.wav doesn't match")
+	}
+
+	// stdin is converted to stdout. The size is unknown without seeking stdin.
+	stdout := &bytes.Buffer{}
+	if err := run(nil, struct{ io.Reader }{bytes.NewReader(src)}, stdout, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if got := stdout.Bytes(); len(got) != want.Len() || !bytes.Equal(got[44:], want.Bytes()[44:]) {
+		t.Errorf("the PCM from stdin doesn't match")
+	}
+
+	if err := run([]string{"-o", out, in, in}, nil, nil, ioutil.Discard); err == nil {
+		t.Errorf("-o with multiple inputs must be an error")
+	}
+
+	// The output is removed on failure.
+	bad := filepath.Join(dir, "bad.mp3")
+	if err := ioutil.WriteFile(bad, []byte("not an MP3 file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run([]string{bad}, nil, nil, ioutil.Discard); err == nil {
+		t.Errorf("converting a bad file must be an error")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "bad.wav")); !os.IsNotExist(err) {
+		t.Errorf("bad.wav must be removed: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"context"
+	"io"
+)
+
+// NewDecoderContext is like NewDecoder but is bound to ctx.
+//
+// After ctx is canceled or its deadline is exceeded, the pre-scan and the returned decoder's Read and Seek fail with
+// ctx.Err() instead of reading r further.
+// A Read call already blocked in r is not interrupted by the decoder itself. To tear down a blocked network read,
+// use a source that is also bound to ctx, e.g. the body of an HTTP request created with http.NewRequestWithContext.
+func NewDecoderContext(ctx context.Context, r io.Reader) (*Decoder, error) {
+	return NewDecoderContextWithOptions(ctx, r, nil)
+}
+
+// NewDecoderContextWithOptions is like NewDecoderContext but takes options.
+func NewDecoderContextWithOptions(ctx context.Context, r io.Reader, options *DecoderOptions) (*Decoder, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	d, err := NewDecoderWithOptions(withContext(ctx, r), options)
+	if err != nil {
+		// Return the context's error as is rather than an error wrapping it, as the decoding error is a result of
+		// the cancellation.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	d.ctx = ctx
+	return d, nil
+}
+
+// contextReader is an io.Reader that fails with the context's error after the context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(buf []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(buf)
+}
+
+// contextReadSeeker is a contextReader that keeps io.Seeker of the source, which is required for scanning and
+// seeking.
+type contextReadSeeker struct {
+	contextReader
+	s io.Seeker
+}
+
+func (c *contextReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.s.Seek(offset, whence)
+}
+
+// contextReadSeekerAt is a contextReadSeeker that keeps io.ReaderAt of the source, which is required for the
+// background scan.
+type contextReadSeekerAt struct {
+	contextReadSeeker
+	ra io.ReaderAt
+}
+
+func (c *contextReadSeekerAt) ReadAt(buf []byte, offset int64) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.ra.ReadAt(buf, offset)
+}
+
+// withContext returns an io.Reader bound to ctx that implements the same interfaces as r among io.Seeker and
+// io.ReaderAt.
+func withContext(ctx context.Context, r io.Reader) io.Reader {
+	cr := contextReader{ctx: ctx, r: r}
+	s, ok := r.(io.Seeker)
+	if !ok {
+		return &cr
+	}
+	crs := contextReadSeeker{contextReader: cr, s: s}
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		return &crs
+	}
+	return &contextReadSeekerAt{contextReadSeeker: crs, ra: ra}
+}
+
+// ctxErr returns errDecoderClosed after Close, or the error of the decoder's context if the context is done.
+func (d *Decoder) ctxErr() error {
+	if d.closed {
+		return errDecoderClosed
+	}
+	if d.ctx == nil {
+		return nil
+	}
+	return d.ctx.Err()
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// cancelingReader cancels the context after n bytes are read.
+type cancelingReader struct {
+	*bytes.Reader
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelingReader) Read(buf []byte) (int, error) {
+	n, err := c.Reader.Read(buf)
+	c.n -= n
+	if c.n <= 0 {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestNewDecoderContext(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	t.Run("scan", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r := &cancelingReader{Reader: bytes.NewReader(src), n: len(src) / 2, cancel: cancel}
+		if _, err := mp3.NewDecoderContext(ctx, r); !errors.Is(err, context.Canceled) {
+			t.Errorf("got: %v, want: %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("read", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		d, err := mp3.NewDecoderContext(ctx, bytes.NewReader(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.CopyN(ioutil.Discard, d, 4096); err != nil {
+			t.Fatal(err)
+		}
+		cancel()
+		if _, err := d.Read(make([]byte, 4096)); !errors.Is(err, context.Canceled) {
+			t.Errorf("Read: got: %v, want: %v", err, context.Canceled)
+		}
+		if _, err := d.Seek(0, io.SeekStart); !errors.Is(err, context.Canceled) {
+			t.Errorf("Seek: got: %v, want: %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := mp3.NewDecoderContext(ctx, bytes.NewReader(src)); !errors.Is(err, context.Canceled) {
+			t.Errorf("got: %v, want: %v", err, context.Canceled)
+		}
+	})
+}
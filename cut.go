@@ -0,0 +1,327 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3/internal/crc"
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+	"github.com/hajimehoshi/go-mp3/internal/xing"
+)
+
+// maxLAMEDelay is the maximum encoder delay or padding in a LAME tag.
+const maxLAMEDelay = 0xfff
+
+// Cut writes the frames of r covering the time range from start to end to w without re-encoding.
+//
+// The first frame written to w is an Info or Xing frame with a LAME tag. The encoder delay and padding in the tag
+// are set so that a gapless decoder like Decoder outputs exactly the samples in the range, which are the same as the
+// samples r is decoded to. The frames just before the range are also written so that the bit reservoir and the
+// overlap of the first frames in the range are restored.
+//
+// If end is 0 or after the end of the stream, the range lasts to the end of the stream.
+//
+// The tags of r are not copied. Cut returns an error wrapping ErrParameterChange when the stream parameters change
+// in the range, or the stream has both MPEG-1 and MPEG-2 frames.
+func Cut(w io.Writer, r io.ReadSeeker, start, end time.Duration) error {
+	if start < 0 || end < 0 || (end != 0 && end <= start) {
+		return fmt.Errorf("mp3: invalid range to cut: %v-%v", start, end)
+	}
+
+	d, err := NewDecoder(r)
+	if err != nil {
+		return err
+	}
+
+	// Convert the range to the positions in the decoded samples.
+	rate := int64(d.sampleRate)
+	length := d.Length() / 4
+	s0 := int64(start) * rate / int64(time.Second)
+	s1 := length
+	if end != 0 {
+		if s := int64(end) * rate / int64(time.Second); s < s1 {
+			s1 = s
+		}
+	}
+	if s0 >= s1 {
+		return fmt.Errorf("mp3: the range to cut %v-%v is out of the stream", start, end)
+	}
+	bytesPerFrame, ok := d.frameSizes.uniform()
+	if !ok {
+		return fmt.Errorf("%w: the stream has both MPEG-1 and MPEG-2 frames", ErrParameterChange)
+	}
+	spf := bytesPerFrame / 4
+	p0 := d.gaplessStart()/4 + s0
+	p1 := d.gaplessStart()/4 + s1
+
+	n := int64(d.frameStarts.len())
+	f0 := p0 / spf
+	f1 := (p1 + spf - 1) / spf
+	if f1 > n {
+		f1 = n
+	}
+
+	var buf []byte
+	fp, err := cutPrimingStart(r, d.frameStarts, f0, p0, spf, &buf)
+	if err != nil {
+		return err
+	}
+
+	// Read the frames once to calculate the Xing header and the LAME tag.
+	sizes := make([]int, 0, f1-fp)
+	var first frameheader.FrameHeader
+	var musicCRC uint16
+	cbr := true
+	for i := fp; i < f1; i++ {
+		frame, err := readFrameAt(r, d.frameStarts.at(int(i)), &buf)
+		if err != nil {
+			// The last frame might be truncated. Copy it as it is.
+			if err != io.ErrUnexpectedEOF || i != n-1 {
+				return err
+			}
+		}
+		h := frameheader.FrameHeader(binary.BigEndian.Uint32(frame))
+		if i == fp {
+			first = h
+		} else {
+			if !sameParameters(first, h) {
+				return fmt.Errorf("%w in the range to cut", ErrParameterChange)
+			}
+			if h.BitrateIndex() != first.BitrateIndex() {
+				cbr = false
+			}
+		}
+		sizes = append(sizes, len(frame))
+		musicCRC = crc.UpdateLAME(musicCRC, frame)
+	}
+
+	delay := p0 - fp*spf - decoderDelay
+	if delay < 0 {
+		delay = 0
+	}
+	padding := f1*spf - p1 + decoderDelay
+	header, err := cutHeaderFrame(d, first, cbr, sizes, int(delay), int(padding), musicCRC)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	for i := fp; i < f1; i++ {
+		frame, err := readFrameAt(r, d.frameStarts.at(int(i)), &buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if len(frame) != sizes[i-fp] {
+			return errors.New("mp3: the source changed while cutting")
+		}
+		if _, err := w.Write(frame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cutPrimingStart returns the first frame to copy for the range starting at the decoded sample p0 in the frame f0.
+//
+// The decoded samples of a frame depend on the overlap of the previous granule, and the main data of a frame can
+// begin in the previous frames. The returned frame is early enough that the frame f0 and the frames it depends on
+// have their main data.
+func cutPrimingStart(r io.ReadSeeker, frameStarts *frameOffsets, f0, p0, spf int64, buf *[]byte) (int64, error) {
+	// The overlap depends on the previous granule, which is in the previous frame for MPEG-1. For MPEG-2, a frame
+	// has one granule and the synthesis filterbank needs more than one frame.
+	depends := int64(1)
+	if spf < 1152 {
+		depends = 2
+	}
+	jmin := f0 - depends
+	if jmin < 0 {
+		jmin = 0
+	}
+	// The encoder delay can't exceed the limit of the LAME tag.
+	min := (p0 - decoderDelay - maxLAMEDelay + spf - 1) / spf
+	if min < 0 {
+		min = 0
+	}
+
+	type frameInfo struct {
+		payload       int
+		mainDataBegin int
+	}
+	infos := map[int64]frameInfo{}
+	info := func(i int64) (frameInfo, error) {
+		if fi, ok := infos[i]; ok {
+			return fi, nil
+		}
+		frame, err := readFrameAt(r, frameStarts.at(int(i)), buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return frameInfo{}, err
+		}
+		fi := frameInfo{
+			payload:       len(frame),
+			mainDataBegin: mainDataBegin(frame),
+		}
+		h := frameheader.FrameHeader(binary.BigEndian.Uint32(frame))
+		fi.payload -= 4 + h.SideInfoSize()
+		if h.ProtectionBit() == 0 {
+			fi.payload -= 2
+		}
+		infos[i] = fi
+		return fi, nil
+	}
+
+	fp := jmin
+	for j := jmin; j <= f0; j++ {
+		fj, err := info(j)
+		if err != nil {
+			return 0, err
+		}
+		for fp > min {
+			reservoir := 0
+			for k := fp; k < j; k++ {
+				fk, err := info(k)
+				if err != nil {
+					return 0, err
+				}
+				reservoir += fk.payload
+			}
+			if reservoir >= fj.mainDataBegin {
+				break
+			}
+			fp--
+		}
+	}
+	return fp, nil
+}
+
+// cutHeaderFrame returns the Info or Xing frame for the frames of the sizes cut from the stream of d.
+func cutHeaderFrame(d *Decoder, first frameheader.FrameHeader, cbr bool, sizes []int, delay, padding int, musicCRC uint16) ([]byte, error) {
+	x := &xing.Header{
+		ID:     "Xing",
+		Flags:  xing.FlagFrames | xing.FlagBytes | xing.FlagTOC,
+		Frames: uint32(len(sizes)),
+		LAME: &xing.LAME{
+			Encoder:  "go-mp3",
+			Delay:    delay,
+			Padding:  padding,
+			MusicCRC: musicCRC,
+		},
+	}
+	if cbr {
+		x.ID = "Info"
+	}
+	if q := d.info.Quality; q >= 0 {
+		x.Flags |= xing.FlagQuality
+		x.Quality = uint32(q)
+	}
+	// Keep the encoder settings. The peak and the ReplayGain values don't hold for the cut stream.
+	if l := d.info.LAME; l != nil {
+		x.LAME.Encoder = l.Encoder
+		x.LAME.VBRMethod = l.VBRMethod
+		x.LAME.Lowpass = l.Lowpass
+		x.LAME.Bitrate = l.Bitrate
+		x.LAME.Preset = l.Preset
+	}
+
+	// Use the smallest bitrate that the header fits with. The header frame has no CRC and no padding.
+	h := first | 0x00010000
+	h &^= 0x00000200
+	for i := 1; i < 15; i++ {
+		h := h&^0x0000f000 | frameheader.FrameHeader(i)<<12
+		size, err := h.FrameSize()
+		if err != nil {
+			return nil, err
+		}
+		if size < xing.Offset(h)+x.Size() {
+			continue
+		}
+
+		var offsets []int64
+		total := int64(size)
+		for _, s := range sizes {
+			offsets = append(offsets, total)
+			total += int64(s)
+		}
+		for j := range x.TOC {
+			x.TOC[j] = byte(offsets[j*len(sizes)/len(x.TOC)] * 256 / total)
+		}
+		x.Bytes = uint32(total)
+		x.LAME.MusicLength = uint32(total)
+
+		frame, ok := x.Frame(h)
+		if !ok {
+			return nil, errors.New("mp3: the Xing header doesn't fit with the frame")
+		}
+		return frame, nil
+	}
+	return nil, errors.New("mp3: no bitrate for the Xing header")
+}
+
+// readFrameAt reads the frame at the position pos of r to buf and returns the frame.
+//
+// readFrameAt returns the read bytes including the frame header and io.ErrUnexpectedEOF when the frame is truncated.
+func readFrameAt(r io.ReadSeeker, pos int64, buf *[]byte) ([]byte, error) {
+	if _, err := r.Seek(pos, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var b [4]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, fmt.Errorf("mp3: reading the frame header at %d: %w", pos, err)
+	}
+	h := frameheader.FrameHeader(binary.BigEndian.Uint32(b[:]))
+	size, err := h.FrameSize()
+	if err != nil {
+		return nil, err
+	}
+	if size < 4 {
+		return nil, fmt.Errorf("mp3: the frame at %d can't be cut: free format is not supported", pos)
+	}
+	if cap(*buf) < size {
+		*buf = make([]byte, size)
+	}
+	frame := (*buf)[:size]
+	copy(frame, b[:])
+	n, err := io.ReadFull(r, frame[4:])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return frame[:4+n], err
+}
+
+// mainDataBegin returns main_data_begin in the side information of the frame, or 0 if the frame is too short.
+func mainDataBegin(frame []byte) int {
+	h := frameheader.FrameHeader(binary.BigEndian.Uint32(frame))
+	i := 4
+	if h.ProtectionBit() == 0 {
+		i += 2
+	}
+	if len(frame) < i+2 {
+		return 0
+	}
+	// main_data_begin is 9 bits for MPEG-1 and 8 bits for MPEG-2 and MPEG-2.5.
+	if h.Granules() == 2 {
+		return int(frame[i])<<1 | int(frame[i+1])>>7
+	}
+	return int(frame[i])
+}
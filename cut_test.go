@@ -0,0 +1,114 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestCut(t *testing.T) {
+	const rate = 22050
+
+	for _, src := range []struct {
+		name string
+		buf  []byte
+	}{
+		{name: "no LAME tag", buf: mustReadFile(t, "example/mpeg2.mp3")},
+		{name: "LAME tag", buf: mpeg2WithLAME(t, 576, 1104)},
+	} {
+		d, err := mp3.NewDecoder(bytes.NewReader(src.buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pcm, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tc := range []struct {
+			start, end time.Duration
+		}{
+			{start: time.Second, end: 2500 * time.Millisecond},
+			{start: 10*time.Second + 123*time.Millisecond, end: 10*time.Second + 124*time.Millisecond},
+			{start: 100 * time.Millisecond, end: 0},
+			{start: 30 * time.Second, end: time.Hour},
+		} {
+			out := &bytes.Buffer{}
+			if err := mp3.Cut(out, bytes.NewReader(src.buf), tc.start, tc.end); err != nil {
+				t.Fatalf("%s: Cut(%v, %v): %v", src.name, tc.start, tc.end, err)
+			}
+
+			s0 := int(tc.start) * rate / int(time.Second) * 4
+			s1 := len(pcm)
+			if tc.end != 0 {
+				if s := int(tc.end) * rate / int(time.Second) * 4; s < s1 {
+					s1 = s
+				}
+			}
+			want := pcm[s0:s1]
+
+			d, err := mp3.NewDecoder(bytes.NewReader(out.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.StreamInfo().LAME; got == nil {
+				t.Errorf("%s: Cut(%v, %v): LAME tag is not found", src.name, tc.start, tc.end)
+			}
+			if got, want := d.Length(), int64(len(want)); got != want {
+				t.Errorf("%s: Cut(%v, %v): Length: got: %d, want: %d", src.name, tc.start, tc.end, got, want)
+			}
+			got, err := ioutil.ReadAll(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: Cut(%v, %v): decoded PCM doesn't match the range of the source", src.name, tc.start, tc.end)
+			}
+		}
+	}
+}
+
+func TestCutInvalidRange(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+	for _, tc := range []struct {
+		start, end time.Duration
+	}{
+		{start: 2 * time.Second, end: time.Second},
+		{start: -time.Second, end: time.Second},
+		{start: time.Hour, end: 0},
+	} {
+		if err := mp3.Cut(ioutil.Discard, bytes.NewReader(src), tc.start, tc.end); err == nil {
+			t.Errorf("Cut(%v, %v) must fail", tc.start, tc.end)
+		}
+	}
+}
+
+func TestCutParameterChange(t *testing.T) {
+	// Concatenate MPEG-2 frames at 22050 Hz and MPEG-1 frames at 44100 Hz.
+	src := append(mustReadFile(t, "example/mpeg2.mp3"), mustReadFile(t, "example/classic.mp3")...)
+	err := mp3.Cut(ioutil.Discard, bytes.NewReader(src), 0, 0)
+	if !errors.Is(err, mp3.ErrParameterChange) {
+		t.Errorf("Cut: got: %v, want: %v", err, mp3.ErrParameterChange)
+	}
+}
@@ -15,8 +15,12 @@
 package mp3
 
 import (
+	"context"
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/hajimehoshi/go-mp3/internal/consts"
 	"github.com/hajimehoshi/go-mp3/internal/frame"
@@ -26,43 +30,404 @@ import (
 // A Decoder is a MP3-decoded stream.
 //
 // Decoder decodes its underlying source on the fly.
+//
+// When the stream has a LAME tag with the encoder delay and padding, Decoder trims the priming samples at the
+// beginning and the padding samples at the end so that the stream is played gaplessly.
 type Decoder struct {
-	source        *source
-	sampleRate    int
-	length        int64
-	frameStarts   []int64
-	buf           []byte
-	frame         *frame.Frame
-	pos           int64
-	bytesPerFrame int64
+	source      *source
+	sampleRate  int
+	length      int64
+	frameStarts *frameOffsets
+	frameSizes  frameSizes
+	buf         pcmBuffer
+	frame       *frame.Frame
+	pos         int64
+	seekMode    SeekMode
+	quality     Quality
+
+	// pcm is the buffer reused for the PCM of each frame.
+	pcm []byte
+
+	// out is the caller's buffer that Read decodes a frame directly into, and outLen is the number of the bytes
+	// decoded into out.
+	out    []byte
+	outLen int
+
+	// scanDone is closed when the background scan finishes. scanDone is nil when no background scan runs.
+	scanDone chan struct{}
+	scanErr  error
+
+	// scanStop is closed by Close to stop the background scan.
+	scanStop chan struct{}
+
+	// closed is true after Close is called.
+	closed bool
+
+	// scanTrailing is the tags at the end of the stream found by the background scan.
+	scanTrailing []tagBlock
+
+	// scanEnd is the end of the audio data found by the background scan, or 0 if the audio data lasts to the end.
+	scanEnd int64
+
+	// scanWarnings is the warnings of the background scan. They are reported on the goroutine calling Read after
+	// the scan finishes. scanWarned is true after they are reported.
+	scanWarnings []Warning
+	scanWarned   bool
+
+	normalizer *loudnessNormalizer
+	onGap      func(r ByteRange)
+
+	// silentFrames is the number of the frames of silence to output for the missing frames of a sparse source
+	// before the next frame is read. silentFrameBytes is the size of a frame of silence.
+	silentFrames     int
+	silentFrameBytes int
+
+	info  StreamInfo
+	first firstFrame
+
+	// segment is the frame header of the first frame of the current segment.
+	segment         frameheader.FrameHeader
+	parameterChange ParameterChangePolicy
+	onSegment       func(info StreamInfo)
+
+	crcMismatch CRCMismatchPolicy
+	frameError  FrameErrorPolicy
+	strict      bool
+	partial     bool
+	memoryLimit MemoryLimit
+	stats       Stats
+	onWarning   func(w Warning)
+	onCRCError  func(offset int64)
+	onResync    func(skippedBytes int64)
+
+	onFrameChecksum func(offset int64, checksum uint32)
+
+	// icy is non-nil when the source has ICY metadata.
+	icy           *icyReader
+	onStreamTitle func(title string, position time.Duration)
+
+	// ctx is the context given to NewDecoderContext. ctx is nil when the decoder is not bound to a context.
+	ctx context.Context
+
+	// lastPCM is the PCM of the last good frame to repeat for a bad frame.
+	lastPCM []byte
+
+	// badFrames is the number of the consecutive bad frames.
+	badFrames int
+
+	// seeked reports whether Seek has been called. After seeking, the frames are not read in order.
+	seeked bool
+
+	// err is the error why the stream ended abruptly.
+	err error
+
+	// framesPerRead is the number of the frames Read returns at once, or 0 when Read ignores the frame boundaries.
+	framesPerRead int
+
+	// readErr is the error held back by Read to return it on the next Read after the PCM read before the error.
+	readErr error
+
+	// spareFrame is the frame reused when there's no previous frame, e.g. after seeking.
+	spareFrame *frame.Frame
+}
+
+// prevFrame returns the previous frame to read the next frame. Without the previous frame, the spare frame is reset and
+// reused so that seeking doesn't allocate a frame.
+func (d *Decoder) prevFrame() *frame.Frame {
+	if d.frame != nil {
+		return d.frame
+	}
+	if d.spareFrame != nil {
+		d.spareFrame.Reset()
+		return d.spareFrame
+	}
+	return nil
+}
+
+// resetFrame forgets the previous frame, e.g. after seeking. The frame is kept as the spare frame.
+func (d *Decoder) resetFrame() {
+	if d.frame != nil {
+		d.spareFrame = d.frame
+	}
+	d.frame = nil
 }
 
 func (d *Decoder) readFrame() error {
-	var err error
-	d.frame, _, err = frame.Read(d.source, d.source.pos, d.frame)
+	d.reportScanWarnings()
+	if d.silentFrames > 0 {
+		return d.readSilentFrame()
+	}
+	if d.source.end == 0 {
+		// The background scan might have found the tags at the end.
+		d.source.end = d.audioEnd()
+	}
+	if ok, err := d.source.atEndTags(); err != nil {
+		return err
+	} else if ok {
+		return io.EOF
+	}
+	start := d.source.pos
+	f, pos, err := frame.Read(d.source, d.source.pos, d.prevFrame(), d.frameOptions())
 	if err != nil {
 		if err == io.EOF {
 			return io.EOF
 		}
+		if pos < start {
+			// The frame header is not read.
+			pos = start
+		}
 		if _, ok := err.(*consts.UnexpectedEOF); ok {
-			// TODO: Log here?
+			d.warn(Warning{
+				Kind:   WarningTruncated,
+				Offset: pos,
+				Err:    err,
+			})
+			d.err = d.positionError(err, pos, d.frameIndex(pos))
 			return io.EOF
 		}
+		// Keep the previous frame so that the synthesis continues smoothly after the bad frame.
+		if d.skipBadFrame(err, start, pos) {
+			return d.appendPCM(d.badFramePCM(), pos)
+		}
+		d.resetFrame()
+		return d.positionError(err, pos, d.frameIndex(pos))
+	}
+	if pos > start {
+		if d.onResync != nil {
+			d.onResync(pos - start)
+		}
+		d.warn(Warning{
+			Kind:   WarningSkippedBytes,
+			Offset: start,
+			Bytes:  pos - start,
+		})
+	}
+	index := d.frameIndex(pos)
+	d.frame = f
+	d.stats.Frames++
+	if f.Truncated() {
+		err := &consts.UnexpectedEOF{"maindata.Read (2)"}
+		d.warn(Warning{
+			Kind:   WarningTruncated,
+			Offset: pos,
+			Err:    err,
+		})
+		d.err = d.positionError(err, pos, index)
+	}
+	if err := d.checkParameters(d.frame.Header()); err != nil {
+		return err
+	}
+	conceal, err := d.checkCRC(pos, index)
+	if err != nil {
 		return err
 	}
-	d.buf = append(d.buf, d.frame.Decode()...)
+	if d.frame.ReservoirUnderflow() {
+		if d.strict {
+			return d.positionError(ErrReservoirUnderflow, pos, index)
+		}
+		d.warn(Warning{
+			Kind:   WarningReservoirUnderflow,
+			Offset: pos,
+		})
+	}
+	var pcm []byte
+	if conceal {
+		pcm = make([]byte, d.frame.Header().BytesPerFrame())
+	} else {
+		pcm = d.frame.DecodeTo(d.pcm)
+		d.pcm = pcm
+		if d.frameError == FrameErrorRepeat {
+			d.lastPCM = append(d.lastPCM[:0], pcm...)
+		}
+	}
+	d.badFrames = 0
+	return d.appendPCM(d.handleGaps(pcm), pos)
+}
+
+// frameOptions returns the options to read frames.
+func (d *Decoder) frameOptions() frame.Options {
+	return frame.Options{
+		Header:            d.source.headerOptions,
+		Partial:           d.partial,
+		MaxReservoirBytes: d.memoryLimit.MaxReservoirBytes,
+		Fast:              d.quality == QualityFast,
+	}
+}
+
+// appendPCM appends the decoded PCM of the frame at offset to the buffer.
+func (d *Decoder) appendPCM(pcm []byte, offset int64) error {
+	if max := d.memoryLimit.MaxBufferedBytes; max > 0 && d.buf.len()+len(pcm) > max {
+		return fmt.Errorf("%w: buffered PCM exceeds %d bytes", ErrMemoryLimit, max)
+	}
+	d.notifyStreamTitles(offset)
+	if d.normalizer != nil {
+		d.normalizer.process(pcm)
+	}
+	d.notifyFrameChecksum(offset, pcm)
+	if d.buf.len() == 0 && len(pcm) > 0 && len(d.out) > 0 && &pcm[0] == &d.out[0] {
+		// The frame is decoded into the caller's buffer.
+		d.outLen = len(pcm)
+		return nil
+	}
+	d.buf.append(pcm)
 	return nil
 }
 
+// maxBytesPerFrame is the maximum size of the PCM of a frame: 2 granules of 2 channels of 16-bit samples.
+const maxBytesPerFrame = 2 * consts.SamplesPerGr * 4
+
+// readDirect reads the next frame by decoding it directly into buf, without copying the PCM via d.buf.
+// buf must be at least maxBytesPerFrame bytes. readDirect returns 0 and no error when the frame is not decoded into buf,
+// e.g. when the frame is concealed. Then, the PCM is in d.buf.
+func (d *Decoder) readDirect(buf []byte) (int, error) {
+	end := d.gaplessEnd()
+	limited := end != invalidLength && d.hasGaplessInfo()
+	if limited && d.pos >= end {
+		return 0, io.EOF
+	}
+
+	pcm := d.pcm
+	// Limit the capacity so that nothing is written beyond the caller's buffer.
+	d.pcm = buf[:0:len(buf)]
+	d.out = buf
+	err := d.readFrame()
+	n := d.outLen
+	d.pcm = pcm
+	d.out = nil
+	d.outLen = 0
+	if err != nil {
+		return 0, err
+	}
+
+	if limited && d.pos+int64(n) > end {
+		// Keep the rest beyond the end as Read does.
+		d.buf.append(buf[end-d.pos : n])
+		n = int(end - d.pos)
+	}
+	d.pos += int64(n)
+	return n, nil
+}
+
 // Read is io.Reader's Read.
+//
+// When DecoderOptions.FramesPerRead is positive, Read returns the PCM of whole frames. See FramesPerRead.
 func (d *Decoder) Read(buf []byte) (int, error) {
-	for len(d.buf) == 0 {
-		if err := d.readFrame(); err != nil {
-			return 0, err
+	if d.framesPerRead > 0 {
+		return d.readFrames(buf)
+	}
+	return d.read(buf)
+}
+
+// read reads the PCM into buf regardless of the frame boundaries.
+func (d *Decoder) read(buf []byte) (int, error) {
+	if err := d.pendingReadErr(); err != nil {
+		return 0, err
+	}
+	if d.buf.len() == 0 && d.pos >= d.gaplessStart() && len(buf) >= maxBytesPerFrame {
+		if n, err := d.readDirect(buf); n > 0 || err != nil {
+			return n, err
 		}
 	}
-	n := copy(buf, d.buf)
-	d.buf = d.buf[n:]
+	n, err := d.fill()
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) > n {
+		buf = buf[:n]
+	}
+	n = copy(buf, d.buf.bytes())
+	d.buf.skip(n)
+	d.pos += int64(n)
+	return n, nil
+}
+
+// pendingReadErr returns the error that was held back by readFrames, or the error of the context.
+func (d *Decoder) pendingReadErr() error {
+	if err := d.readErr; err != nil {
+		d.readErr = nil
+		return err
+	}
+	return d.ctxErr()
+}
+
+// fill decodes frames until d.buf has PCM to output, skipping the priming samples, and returns the number of the
+// bytes Read can output from d.buf.
+func (d *Decoder) fill() (int, error) {
+	start := d.gaplessStart()
+	for {
+		for d.buf.len() == 0 {
+			if err := d.readFrame(); err != nil {
+				return 0, err
+			}
+		}
+		if d.pos >= start {
+			break
+		}
+		// Skip the priming samples.
+		n := start - d.pos
+		if n > int64(d.buf.len()) {
+			n = int64(d.buf.len())
+		}
+		d.buf.skip(int(n))
+		d.pos += n
+	}
+	// Without the gapless information, the output is not limited by the length, which is only an estimation by
+	// the frame headers.
+	if end := d.gaplessEnd(); end != invalidLength && d.hasGaplessInfo() {
+		if d.pos >= end {
+			return 0, io.EOF
+		}
+		if int64(d.buf.len()) > end-d.pos {
+			return int(end - d.pos), nil
+		}
+	}
+	return d.buf.len(), nil
+}
+
+// readFrames reads the PCM of up to d.framesPerRead whole frames into buf.
+// An error after some frames are read is held back until the next Read.
+func (d *Decoder) readFrames(buf []byte) (int, error) {
+	if err := d.pendingReadErr(); err != nil {
+		return 0, err
+	}
+	var n int
+	for i := 0; i < d.framesPerRead; i++ {
+		m, err := d.readWholeFrame(buf[n:])
+		n += m
+		if err == io.ErrShortBuffer && n > 0 {
+			break
+		}
+		if err != nil {
+			if n == 0 {
+				return 0, err
+			}
+			d.readErr = err
+			break
+		}
+	}
+	return n, nil
+}
+
+// readWholeFrame reads the PCM of the next frame into buf. readWholeFrame returns io.ErrShortBuffer when buf is too
+// small for the frame, and the frame is kept for the next call.
+func (d *Decoder) readWholeFrame(buf []byte) (int, error) {
+	if err := d.ctxErr(); err != nil {
+		return 0, err
+	}
+	if d.buf.len() == 0 && d.pos >= d.gaplessStart() && len(buf) >= maxBytesPerFrame {
+		if n, err := d.readDirect(buf); n > 0 || err != nil {
+			return n, err
+		}
+	}
+	n, err := d.fill()
+	if err != nil {
+		return 0, err
+	}
+	if n > len(buf) {
+		return 0, io.ErrShortBuffer
+	}
+	copy(buf, d.buf.bytes()[:n])
+	d.buf.skip(n)
 	d.pos += int64(n)
 	return n, nil
 }
@@ -74,51 +439,161 @@ func (d *Decoder) Read(buf []byte) (int, error) {
 // Note that seek uses a byte offset but samples are aligned to 4 bytes (2
 // channels, 2 bytes each). Be careful to seek to an offset that is divisible by
 // 4 if you want to read at full sample boundaries.
+//
+// With SeekModeFast, the offset is rounded down to the start of its frame and
+// Seek returns the rounded offset.
+// If the background scan is not finished and the stream has a Xing or Info header with a TOC,
+// Seek with SeekModeFast doesn't wait for the scan and uses the TOC instead.
+// In this case, the returned offset is an estimation.
 func (d *Decoder) Seek(offset int64, whence int) (int64, error) {
 	if offset == 0 && whence == io.SeekCurrent {
 		// Handle the special case of asking for the current position specially.
-		return d.pos, nil
+		return d.outputPosition(d.pos), nil
+	}
+	if err := d.ctxErr(); err != nil {
+		return 0, err
+	}
+	if _, ok := d.source.reader.(io.Seeker); !ok {
+		return 0, errors.New("mp3: source must be io.Seeker")
+	}
+	d.seeked = true
+	d.err = nil
+
+	if d.seekMode == SeekModeFast && !d.scanFinished() && d.canSeekByTOC() {
+		npos, err := d.seekPosition(offset, whence)
+		if err != nil {
+			return 0, err
+		}
+		npos, err = d.seekByTOC(npos)
+		if err != nil {
+			return 0, err
+		}
+		return d.outputPosition(npos), nil
+	}
+
+	if err := d.waitScan(); err != nil {
+		return 0, err
+	}
+
+	npos, err := d.seekPosition(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+	// The frame sizes can differ from frame to frame when the stream has MPEG-1 and MPEG-2 segments.
+	f, skip := d.frameSizes.frameAt(npos)
+	if d.seekMode == SeekModeFast {
+		npos -= skip
+	}
+	d.pos = npos
+	d.buf.reset()
+	d.readErr = nil
+	d.resetFrame()
+	if f >= int64(d.frameStarts.len()) {
+		// The position is at or beyond the end. Read returns io.EOF.
+		if _, err := d.source.Seek(0, io.SeekEnd); err != nil {
+			return 0, err
+		}
+		return d.outputPosition(npos), nil
+	}
+	if d.seekMode == SeekModeFast {
+		if err := d.seekToFrame(f, false); err != nil {
+			return 0, err
+		}
+		return d.outputPosition(npos), nil
+	}
+	// Decode the previous frames ahead of reading the targeted frame
+	// because the previous frames can affect the targeted frame.
+	if err := d.seekToFrame(f, true); err != nil {
+		return 0, err
 	}
+	if err := d.readFrame(); err != nil {
+		return 0, err
+	}
+	// The frame might produce no PCM, e.g. when it is dropped by FrameErrorSkip. Then the rest of the bytes to skip
+	// are carried into the following frames.
+	for skip > 0 {
+		if d.buf.len() == 0 {
+			if err := d.readFrame(); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return 0, err
+			}
+			continue
+		}
+		n := d.buf.len()
+		if int64(n) > skip {
+			n = int(skip)
+		}
+		d.buf.skip(n)
+		skip -= int64(n)
+	}
+	return d.outputPosition(npos), nil
+}
 
-	npos := int64(0)
+// seekPosition returns the absolute position in the decoded bytes for Seek's arguments.
+func (d *Decoder) seekPosition(offset int64, whence int) (int64, error) {
+	var npos int64
 	switch whence {
 	case io.SeekStart:
 		npos = offset
 	case io.SeekCurrent:
-		npos = d.pos + offset
+		npos = d.outputPosition(d.pos) + offset
 	case io.SeekEnd:
 		npos = d.Length() + offset
 	default:
 		return 0, errors.New("mp3: invalid whence")
 	}
-	d.pos = npos
-	d.buf = nil
-	d.frame = nil
-	f := d.pos / d.bytesPerFrame
-	// If the frame is not first, read the previous ahead of reading that
-	// because the previous frame can affect the targeted frame.
-	if f > 0 {
-		f--
-		if _, err := d.source.Seek(d.frameStarts[f], 0); err != nil {
-			return 0, err
-		}
-		if err := d.readFrame(); err != nil {
-			return 0, err
-		}
-		if err := d.readFrame(); err != nil {
-			return 0, err
+	if npos < 0 {
+		return 0, errors.New("mp3: negative position")
+	}
+	return npos + d.gaplessStart(), nil
+}
+
+const (
+	// maxMainDataBegin is the maximum number of bytes main data can refer back to.
+	maxMainDataBegin = 511
+
+	// maxFrameOverhead is the maximum number of bytes of a frame that are not main data
+	// (header, CRC and side information).
+	maxFrameOverhead = 4 + 2 + 32
+)
+
+// seekToFrame positions the source at the f-th frame.
+//
+// The preceding frames are read so that the bit reservoir for the f-th frame is filled.
+// If decodePrev is true, the two frames just before the f-th frame are also decoded
+// so that the synthesis state is primed. Their PCM is discarded.
+func (d *Decoder) seekToFrame(f int64, decodePrev bool) error {
+	first := f
+	if decodePrev {
+		first -= 2
+		if first < 0 {
+			first = 0
 		}
-		d.buf = d.buf[d.bytesPerFrame+(d.pos%d.bytesPerFrame):]
-	} else {
-		if _, err := d.source.Seek(d.frameStarts[f], 0); err != nil {
-			return 0, err
+	}
+	p := first
+	offset := d.frameStarts.at(int(p))
+	for size := int64(0); p > 0 && size < maxMainDataBegin; {
+		p--
+		prev := d.frameStarts.at(int(p))
+		size += offset - prev - maxFrameOverhead
+		offset = prev
+	}
+	if _, err := d.source.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	for i := p; i < f; i++ {
+		fr, _, err := frame.Read(d.source, d.source.pos, d.prevFrame(), d.frameOptions())
+		if err != nil {
+			return err
 		}
-		if err := d.readFrame(); err != nil {
-			return 0, err
+		if i >= first {
+			d.pcm = fr.DecodeTo(d.pcm)
 		}
-		d.buf = d.buf[d.pos:]
+		d.frame = fr
 	}
-	return npos, nil
+	return nil
 }
 
 // SampleRate returns the sample rate like 44100.
@@ -128,7 +603,10 @@ func (d *Decoder) SampleRate() int {
 	return d.sampleRate
 }
 
-func (d *Decoder) ensureFrameStartsAndLength() error {
+// scanProgressInterval is the number of frames between calls of the scan progress callback.
+const scanProgressInterval = 1000
+
+func (d *Decoder) ensureFrameStartsAndLength(progress func(bytes int64, frames int) error) error {
 	if d.length != invalidLength {
 		return nil
 	}
@@ -149,83 +627,485 @@ func (d *Decoder) ensureFrameStartsAndLength() error {
 	if err := d.source.skipTags(); err != nil {
 		return err
 	}
-	l := int64(0)
-	for {
-		h, pos, err := frameheader.Read(d.source, d.source.pos)
+
+	// Exclude the tags at the end so that only the audio frames are counted.
+	if rs, ok := d.source.reader.(io.ReadSeeker); ok {
+		start := d.source.pos
+		size, err := sourceSize(rs)
+		if err != nil {
+			return err
+		}
+		if err := d.source.excludeEndTags(readSeekerAt{r: rs}, start, size); err != nil {
+			return err
+		}
+		if _, err := d.source.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	frameStarts, length, frameSizes, err := scanFrames(d.source, d.first.metadata, d.memoryLimit.MaxIndexEntries, progress, d.warn)
+	if err != nil {
+		return err
+	}
+	d.frameStarts = frameStarts
+	d.length = length
+	d.frameSizes = frameSizes
+
+	if _, err := d.source.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+	return nil
+}
+
+// startBackgroundScan starts scanning the stream on another goroutine.
+// The scan reads r independently from the decoder's source.
+//
+// The goroutine refers to d and reads r until the scan finishes, fails, or is stopped by Close.
+// When the decoder is bound to a context, canceling the context also stops the scan as r fails.
+func (d *Decoder) startBackgroundScan(r io.ReaderAt, size int64, progress func(bytes int64, frames int) error) {
+	end := d.source.end
+	d.scanDone = make(chan struct{})
+	d.scanStop = make(chan struct{})
+	r = &stoppableReaderAt{r: r, stop: d.scanStop}
+	go func() {
+		defer close(d.scanDone)
+		s := &source{
+			reader:        io.NewSectionReader(r, 0, size),
+			end:           end,
+			headerOptions: d.source.headerOptions,
+		}
+		if err := s.skipTags(); err != nil {
+			d.scanErr = err
+			return
+		}
+		// Exclude the tags at the end so that only the audio frames are counted.
+		if err := s.excludeEndTags(r, s.pos, size); err != nil {
+			d.scanErr = err
+			return
+		}
+		// OnWarning must not be called on this goroutine. The warnings are kept and reported by reportScanWarnings.
+		warn := func(w Warning) {
+			d.scanWarnings = append(d.scanWarnings, w)
+		}
+		frameStarts, length, frameSizes, err := scanFrames(s, d.first.metadata, d.memoryLimit.MaxIndexEntries, progress, warn)
+		if err != nil {
+			d.scanErr = err
+			return
+		}
+		d.frameStarts = frameStarts
+		d.length = length
+		d.frameSizes = frameSizes
+		d.scanTrailing = s.trailing
+		d.scanEnd = s.end
+	}()
+}
+
+// scanFinished reports whether the length and the frame positions are settled.
+func (d *Decoder) scanFinished() bool {
+	if d.scanDone == nil {
+		return true
+	}
+	select {
+	case <-d.scanDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// trailingTags returns the tags at the end of the stream found so far.
+func (d *Decoder) trailingTags() []tagBlock {
+	if len(d.source.trailing) > 0 {
+		return d.source.trailing
+	}
+	if d.scanFinished() {
+		return d.scanTrailing
+	}
+	return nil
+}
+
+// audioEnd returns the end of the audio data found so far, or 0 if the audio data lasts to the end.
+func (d *Decoder) audioEnd() int64 {
+	if d.source.end != 0 {
+		return d.source.end
+	}
+	if d.scanFinished() {
+		return d.scanEnd
+	}
+	return 0
+}
+
+// trailingTag returns the tag of the format at the end of the stream, or nil if the tag is not found yet.
+func (d *Decoder) trailingTag(format TagFormat) *tagBlock {
+	tags := d.trailingTags()
+	for i := range tags {
+		if tags[i].format == format {
+			return &tags[i]
+		}
+	}
+	return nil
+}
+
+// stoppableReaderAt is an io.ReaderAt that fails with errDecoderClosed after stop is closed.
+type stoppableReaderAt struct {
+	r    io.ReaderAt
+	stop chan struct{}
+}
+
+func (s *stoppableReaderAt) ReadAt(buf []byte, offset int64) (int, error) {
+	select {
+	case <-s.stop:
+		return 0, errDecoderClosed
+	default:
+	}
+	return s.r.ReadAt(buf, offset)
+}
+
+// errDecoderClosed is the error when a closed decoder is used.
+var errDecoderClosed = errors.New("mp3: decoder is closed")
+
+// Close stops the background scan if any and waits for it to finish, so that the source is no longer read after
+// Close returns. Close doesn't close the source.
+//
+// After Close, Read and Seek fail. Close is needed only to stop the background scan early: a decoder whose scan is
+// finished can be discarded without Close.
+func (d *Decoder) Close() error {
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	if d.scanStop != nil {
+		close(d.scanStop)
+		<-d.scanDone
+	}
+	return nil
+}
+
+// waitScan waits for the background scan if any.
+func (d *Decoder) waitScan() error {
+	if d.scanDone == nil {
+		return nil
+	}
+	<-d.scanDone
+	d.reportScanWarnings()
+	return d.scanErr
+}
+
+// reportScanWarnings reports the warnings of the background scan to the OnWarning callback if the scan is finished.
+// The warnings are reported only once.
+func (d *Decoder) reportScanWarnings() {
+	if d.scanDone == nil || d.scanWarned || !d.scanFinished() {
+		return
+	}
+	d.scanWarned = true
+	for _, w := range d.scanWarnings {
+		d.warn(w)
+	}
+	d.scanWarnings = nil
+}
+
+// scanFrames reads the frame headers from the current position of s to the end,
+// and returns the frame positions, the total size of the decoded stream in bytes, and the decoded sizes of the frames.
+//
+// If skipFirst is true, the first frame is not counted as it is a Xing, Info or VBRI frame.
+// If maxEntries is positive, scanFrames fails when the stream has more frames than maxEntries.
+// warn is called with WarningTruncated when the stream ends in the middle of a frame.
+func scanFrames(s *source, skipFirst bool, maxEntries int, progress func(bytes int64, frames int) error, warn func(w Warning)) (frameStarts *frameOffsets, length int64, sizes frameSizes, err error) {
+	frameStarts = &frameOffsets{}
+	for first := true; ; first = false {
+		if ok, err := s.atEndTags(); err != nil {
+			return nil, 0, nil, err
+		} else if ok {
+			break
+		}
+		start := s.pos
+		h, pos, err := readScanHeader(s)
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
 			if _, ok := err.(*consts.UnexpectedEOF); ok {
-				// TODO: Log here?
+				if pos < start {
+					// The frame header is not read.
+					pos = start
+				}
+				warn(Warning{
+					Kind:   WarningTruncated,
+					Offset: pos,
+					Err:    err,
+				})
 				break
 			}
-			return err
+			return nil, 0, nil, err
+		}
+		if !first || !skipFirst {
+			if maxEntries > 0 && frameStarts.len() >= maxEntries {
+				return nil, 0, nil, fmt.Errorf("%w: the frame index exceeds %d entries", ErrMemoryLimit, maxEntries)
+			}
+			size := int64(h.BytesPerFrame())
+			if n := len(sizes); n == 0 || sizes[n-1].size != size {
+				sizes = append(sizes, frameSizeRun{
+					frame: int64(frameStarts.len()),
+					pos:   length,
+					size:  size,
+				})
+			}
+			frameStarts.append(pos)
+			length += size
 		}
-		d.frameStarts = append(d.frameStarts, pos)
-		d.bytesPerFrame = int64(h.BytesPerFrame())
-		l += d.bytesPerFrame
 
 		framesize, err := h.FrameSize()
 		if err != nil {
-			return err
+			return nil, 0, nil, err
 		}
-		buf := make([]byte, framesize-4)
-		if _, err := d.source.ReadFull(buf); err != nil {
+		// Skip the frame body without parsing it.
+		if err := s.discard(int64(framesize - 4)); err != nil {
 			if err == io.EOF {
+				warn(Warning{
+					Kind:   WarningTruncated,
+					Offset: pos,
+					Err:    &consts.UnexpectedEOF{"scanFrames"},
+				})
 				break
 			}
-			return err
+			return nil, 0, nil, err
+		}
+
+		if n := frameStarts.len(); progress != nil && n > 0 && n%scanProgressInterval == 0 {
+			if err := progress(s.pos, n); err != nil {
+				return nil, 0, nil, err
+			}
 		}
 	}
-	d.length = l
+	if progress != nil {
+		if err := progress(s.pos, frameStarts.len()); err != nil {
+			return nil, 0, nil, err
+		}
+	}
+	return frameStarts, length, sizes, nil
+}
 
-	if _, err := d.source.Seek(pos, io.SeekStart); err != nil {
-		return err
+// readScanHeader reads the next frame header for scanFrames.
+// A valid frame header at the current position is read from the buffer read ahead without allocations. Otherwise,
+// frameheader.Read finds the next frame header.
+func readScanHeader(s *source) (frameheader.FrameHeader, int64, error) {
+	if b, err := s.peek(4); err == nil && len(b) == 4 {
+		h := frameheader.FrameHeader(binary.BigEndian.Uint32(b))
+		if h.IsValidWithOptions(s.headerOptions) && h.BitrateIndex() != 0 {
+			pos := s.pos
+			if err := s.discard(4); err != nil {
+				return 0, 0, err
+			}
+			return h, pos, nil
+		}
 	}
-	return nil
+	return frameheader.Read(s, s.pos, s.headerOptions, nil)
 }
 
 const invalidLength = -1
 
 // Length returns the total size in bytes.
 //
-// Length returns -1 when the total size is not available
-// e.g. when the given source is not io.Seeker.
+// When the stream is not scanned, e.g. when the given source is not io.Seeker or when the background scan is not
+// finished yet, Length returns the size calculated from the number of frames in the Xing or Info header.
+// Length returns -1 when the total size is not available in either way.
+//
+// Length excludes the samples trimmed for gapless playback.
 func (d *Decoder) Length() int64 {
+	end := d.gaplessEnd()
+	if end == invalidLength {
+		return invalidLength
+	}
+	return end - d.gaplessStart()
+}
+
+// Duration returns the total duration of the stream.
+//
+// Duration is calculated from Length, so Duration is exact even for VBR streams when the stream is scanned or
+// has a Xing or Info header with the number of frames.
+// Duration returns -1 when Length returns -1.
+func (d *Decoder) Duration() time.Duration {
+	l := d.Length()
+	if l == invalidLength {
+		return -1
+	}
+	samples := l / 4
+	rate := int64(d.sampleRate)
+	return time.Duration(samples/rate)*time.Second + time.Duration(samples%rate)*time.Second/time.Duration(rate)
+}
+
+// decodedLength returns the total size of the decoded bytes before the gapless trimming.
+func (d *Decoder) decodedLength() int64 {
+	if !d.scanFinished() || d.length == invalidLength {
+		return d.lengthFromStreamInfo()
+	}
 	return d.length
 }
 
+// noValidFramesError returns the error for err, which happens before any valid frame is found.
+// firstHeader is the first 4 bytes of the audio data at audioStart, and scanned is the number of the read bytes.
+func noValidFramesError(err error, firstHeader frameheader.FrameHeader, audioStart, scanned int64) error {
+	if err != io.EOF && !errors.Is(err, ErrNoSync) && !errors.Is(err, ErrResync) {
+		return err
+	}
+	// A frame header of the reserved version is skipped as garbage while finding a frame.
+	if firstHeader.HasReservedVersion() {
+		return fmt.Errorf("%w: header word is 0x%08x at position %d", ErrUnsupportedVersion, uint32(firstHeader),
+			audioStart)
+	}
+	if err == io.EOF {
+		return &NoValidFramesError{BytesScanned: scanned}
+	}
+	return err
+}
+
 // NewDecoder decodes the given io.Reader and returns a decoded stream.
 //
 // The stream is always formatted as 16bit (little endian) 2 channels
 // even if the source is single channel MP3.
 // Thus, a sample always consists of 4 bytes.
 func NewDecoder(r io.Reader) (*Decoder, error) {
+	return NewDecoderWithOptions(r, nil)
+}
+
+// NewDecoderWithOptions is like NewDecoder but takes options.
+//
+// options can be nil. A nil options is the same as the default options.
+func NewDecoderWithOptions(r io.Reader, options *DecoderOptions) (*Decoder, error) {
+	if options == nil {
+		options = &DecoderOptions{}
+	}
 	s := &source{
 		reader: r,
+		headerOptions: frameheader.Options{
+			AcceptReservedEmphasis: options.AcceptReservedEmphasis,
+			MaxResyncBytes:         options.MaxResyncBytes,
+			SyncConfirmations:      options.SyncConfirmations,
+			DisallowResync:         options.Strict,
+		},
 	}
 	d := &Decoder{
-		source: s,
-		length: invalidLength,
+		source:   s,
+		length:   invalidLength,
+		seekMode: options.SeekMode,
+		quality:  options.Quality,
+		onGap:    options.OnGap,
+
+		parameterChange: options.ParameterChange,
+		onSegment:       options.OnSegment,
+		crcMismatch:     options.CRCMismatch,
+		frameError:      options.FrameError,
+		strict:          options.Strict,
+		partial:         options.PartialFinalFrame,
+		memoryLimit:     options.MemoryLimit,
+		onWarning:       options.OnWarning,
+		onCRCError:      options.OnCRCError,
+		onResync:        options.OnResync,
+		onFrameChecksum: options.OnFrameChecksum,
+		framesPerRead:   options.FramesPerRead,
+	}
+	if options.Scratch != nil {
+		d.useScratch(options.Scratch)
+	}
+
+	if options.ICYMetaInt > 0 {
+		// The ICY stream is not seekable.
+		icy, err := d.setUpICY(r, options.ICYMetaInt, options.OnStreamTitle)
+		if err != nil {
+			return nil, err
+		}
+		r = icy
+		s.reader = icy
 	}
 
 	if err := s.skipTags(); err != nil {
 		return nil, err
 	}
-	// TODO: Is readFrame here really needed?
-	if err := d.readFrame(); err != nil {
+	if err := s.detectFormat(); err != nil {
 		return nil, err
 	}
+	// Keep the first header to tell the reason when no valid frames are found.
+	audioStart := s.pos
+	var firstHeader frameheader.FrameHeader
+	if b, err := s.peek(4); err == nil && len(b) == 4 {
+		firstHeader = frameheader.FrameHeader(binary.BigEndian.Uint32(b))
+	}
+	info, first, err := readStreamInfo(s)
+	if err != nil {
+		return nil, noValidFramesError(err, firstHeader, audioStart, s.pos)
+	}
+	d.info = info
+	d.first = first
+
+	// Set up ReplayGain before the first frame is decoded so that the gain applies to all the frames.
+	if options.ReplayGain != ReplayGainOff {
+		if options.NormalizeLoudness {
+			return nil, errors.New("mp3: ReplayGain and NormalizeLoudness can't be used together")
+		}
+		if err := d.setUpReplayGain(options.ReplayGain, options.ReplayGainPreamp); err != nil {
+			return nil, err
+		}
+	}
+
+	// TODO: Is readFrame here really needed?
+	// Bad frames might be skipped by the FrameError policy. Read until a frame is decoded.
+	for d.frame == nil {
+		if err := d.readFrame(); err != nil {
+			return nil, noValidFramesError(err, firstHeader, audioStart, s.pos)
+		}
+	}
 	freq, err := d.frame.SamplingFrequency()
 	if err != nil {
 		return nil, err
 	}
 	d.sampleRate = freq
 
-	if err := d.ensureFrameStartsAndLength(); err != nil {
+	if r, ok := r.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok && options.BackgroundScan {
+		size, err := sourceSize(r)
+		if err != nil {
+			return nil, err
+		}
+		d.startBackgroundScan(r, size, options.ScanProgress)
+		if options.NormalizeLoudness {
+			// The length is not settled until the scan finishes. Follow the running estimate of the loudness.
+			if err := d.setUpLoudnessNormalization(false); err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+	}
+
+	if err := d.ensureFrameStartsAndLength(options.ScanProgress); err != nil {
 		return nil, err
 	}
 
+	if options.ReplayGain != ReplayGainOff {
+		// The scan might have found the tags at the end of the stream with ReplayGain values.
+		if err := d.setUpReplayGain(options.ReplayGain, options.ReplayGainPreamp); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.NormalizeLoudness {
+		if err := d.setUpLoudnessNormalization(d.length != invalidLength); err != nil {
+			return nil, err
+		}
+	}
+
 	return d, nil
 }
+
+// NewReaderAtDecoder returns a decoder that reads the given io.ReaderAt of size bytes.
+//
+// The decoder reads r only via ReadAt and never changes r's state like a file offset.
+// Thus, multiple decoders can share the same r, e.g. an *os.File, and can be used on different goroutines
+// concurrently as long as r's ReadAt is safe for concurrent use.
+// Note that a Decoder itself is not safe for concurrent use.
+//
+// The returned decoder is seekable.
+func NewReaderAtDecoder(r io.ReaderAt, size int64) (*Decoder, error) {
+	return NewDecoder(io.NewSectionReader(r, 0, size))
+}
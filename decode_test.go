@@ -0,0 +1,1410 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+var decodedCache = map[string][]byte{}
+
+func decodeAll(t *testing.T, path string, options *mp3.DecoderOptions) []byte {
+	t.Helper()
+	if options == nil {
+		if pcm, ok := decodedCache[path]; ok {
+			return pcm
+		}
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcm, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options == nil {
+		decodedCache[path] = pcm
+	}
+	return pcm
+}
+
+func TestSeekModeAccurate(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, offset := range []int64{0, 4, 576 * 4, 5*576*4 + 100*4, 1000*576*4 + 4} {
+		pos, err := d.Seek(offset, io.SeekStart)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos != offset {
+			t.Errorf("Seek(%d): got: %d, want: %d", offset, pos, offset)
+		}
+		got := make([]byte, 2*576*4)
+		if _, err := io.ReadFull(d, got); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want[offset:offset+int64(len(got))]) {
+			t.Errorf("decoded PCM after Seek(%d) doesn't match", offset)
+		}
+	}
+}
+
+func TestSeekFrameErrorSkip(t *testing.T) {
+	const index = 10
+	const frameSize = 576 * 4
+	src := corruptedMPEG2(t, index)
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		FrameError: mp3.FrameErrorSkip,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The frame at the position is dropped, so the bytes to skip are carried into the next frame.
+	const offset = index*frameSize + 100
+	if _, err := d.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(got), len(want)-offset-frameSize; got != want {
+		t.Errorf("decoded length after Seek: got: %d, want: %d", got, want)
+	}
+}
+
+func TestSeekModeFast(t *testing.T) {
+	const bytesPerFrame = 576 * 4
+
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+		SeekMode: mp3.SeekModeFast,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const offset = 100*bytesPerFrame + 123*4
+	pos, err := d.Seek(offset, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pos, int64(100*bytesPerFrame); got != want {
+		t.Errorf("Seek: got: %d, want: %d", got, want)
+	}
+	got := make([]byte, 4*bytesPerFrame)
+	if _, err := io.ReadFull(d, got); err != nil {
+		t.Fatal(err)
+	}
+	// The first frames after a fast seek are not primed, but the following frames must be identical.
+	if !bytes.Equal(got[2*bytesPerFrame:], want[pos+2*bytesPerFrame:pos+int64(len(got))]) {
+		t.Errorf("decoded PCM after a fast seek doesn't match")
+	}
+}
+
+func TestScanProgress(t *testing.T) {
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lastBytes int64
+	var lastFrames int
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+		ScanProgress: func(bytes int64, frames int) error {
+			if bytes < lastBytes || frames < lastFrames {
+				t.Errorf("progress went backwards: (%d, %d) -> (%d, %d)", lastBytes, lastFrames, bytes, frames)
+			}
+			lastBytes = bytes
+			lastFrames = frames
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := int64(lastFrames)*576*4, d.Length(); got != want {
+		t.Errorf("frames: got: %d, want: %d", got, want)
+	}
+
+	errCanceled := errors.New("canceled")
+	if _, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+		ScanProgress: func(bytes int64, frames int) error {
+			return errCanceled
+		},
+	}); err != errCanceled {
+		t.Errorf("NewDecoderWithOptions: got: %v, want: %v", err, errCanceled)
+	}
+}
+
+func TestBackgroundScan(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+		BackgroundScan: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := make([]byte, 10*576*4)
+	if _, err := io.ReadFull(d, head); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, want[:len(head)]) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+
+	// Seek waits for the scan.
+	const offset = 100 * 576 * 4
+	if _, err := d.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Length(), int64(len(want)); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	rest, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rest, want[offset:]) {
+		t.Errorf("decoded PCM after Seek doesn't match")
+	}
+}
+
+func TestReaderAtDecoders(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := bytes.NewReader(buf)
+
+	const offset = 500 * 576 * 4
+	var pcms [2][]byte
+	done := make(chan error)
+	for i := range pcms {
+		i := i
+		go func() {
+			d, err := mp3.NewReaderAtDecoder(r, int64(len(buf)))
+			if err != nil {
+				done <- err
+				return
+			}
+			if i == 1 {
+				if _, err := d.Seek(offset, io.SeekStart); err != nil {
+					done <- err
+					return
+				}
+			}
+			pcm, err := ioutil.ReadAll(d)
+			pcms[i] = pcm
+			done <- err
+		}()
+	}
+	for range pcms {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(pcms[0], want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+	if !bytes.Equal(pcms[1], want[offset:]) {
+		t.Errorf("decoded PCM after Seek doesn't match")
+	}
+}
+
+func TestClone(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const offset = 200 * 576 * 4
+	if _, err := d.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	c, err := d.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+
+	// Interleave reads of the two decoders.
+	var got0, got1 []byte
+	tmp := make([]byte, 1000*4)
+	for {
+		n0, err0 := d.Read(tmp)
+		got0 = append(got0, tmp[:n0]...)
+		n1, err1 := c.Read(tmp)
+		got1 = append(got1, tmp[:n1]...)
+		if err0 == io.EOF && err1 == io.EOF {
+			break
+		}
+		if err0 != nil && err0 != io.EOF {
+			t.Fatal(err0)
+		}
+		if err1 != nil && err1 != io.EOF {
+			t.Fatal(err1)
+		}
+	}
+	if !bytes.Equal(got0, want[offset:]) {
+		t.Errorf("decoded PCM of the original doesn't match")
+	}
+	if !bytes.Equal(got1, want) {
+		t.Errorf("decoded PCM of the clone doesn't match")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	const want = time.Duration(mpeg2Frames) * 576 * time.Second / 22050
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Duration(); got != want {
+		t.Errorf("Duration: got: %v, want: %v", got, want)
+	}
+
+	// The duration of a stream that is not scanned is calculated from the number of frames in the Xing header.
+	d, err = mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(mpeg2WithXing(t))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Duration(); got != want {
+		t.Errorf("Duration: got: %v, want: %v", got, want)
+	}
+
+	// The duration of a stream without a Xing header is unknown until the stream is scanned.
+	d, err = mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Duration(), time.Duration(-1); got != want {
+		t.Errorf("Duration: got: %v, want: %v", got, want)
+	}
+}
+
+func TestID3v1AtEnd(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	tag := make([]byte, 128)
+	copy(tag, "TAG")
+	// The title looks like a frame header of MPEG-1 Layer I.
+	copy(tag[3:], "\xff\xfe\x90\x00")
+	buf := append(mustReadFile(t, "example/mpeg2.mp3"), tag...)
+
+	for _, r := range []io.Reader{
+		bytes.NewReader(buf),
+		// Hide Seek so that the stream is not scanned.
+		struct{ io.Reader }{bytes.NewReader(buf)},
+	} {
+		d, err := mp3.NewDecoder(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := r.(io.Seeker); ok {
+			if got, want := d.Length(), int64(len(want)); got != want {
+				t.Errorf("Length: got: %d, want: %d", got, want)
+			}
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("decoded PCM doesn't match")
+		}
+	}
+}
+
+func TestID3v2FooterAndExtendedHeader(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	// An ID3v2.4 tag with an extended header (6 bytes), a TIT2 frame and a footer.
+	body := []byte("\x00\x00\x00\x06\x01\x00" + "TIT2\x00\x00\x00\x04\x00\x00\x03abc")
+	size := string([]byte{0, 0, 0, byte(len(body))})
+	tag := append([]byte("ID3\x04\x00\x50"+size), body...)
+	tag = append(tag, "3DI\x04\x00\x50"+size...)
+	buf := append(tag, mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Length(), int64(len(want)); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+}
+
+func TestID3v2InStream(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	audio := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+
+	// An ID3v2 tag whose content looks like frame headers.
+	body := bytes.Repeat([]byte("\xff\xfe\x90\x00"), 16)
+	tag := append([]byte("ID3\x03\x00\x00\x00\x00\x00"+string([]byte{byte(len(body))})), body...)
+
+	// The audio is concatenated with tags in the middle and at the end.
+	var buf []byte
+	buf = append(buf, tag...)
+	buf = append(buf, tag...)
+	buf = append(buf, audio...)
+	buf = append(buf, tag...)
+	buf = append(buf, audio...)
+	buf = append(buf, tag...)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Length(), int64(2*len(want)); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got[:len(want)], want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+	if got, want := len(got), 2*len(want); got != want {
+		t.Errorf("decoded length: got: %d, want: %d", got, want)
+	}
+}
+
+func TestRIFFWAVE(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	audio := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+
+	chunk := func(id string, body []byte) []byte {
+		buf := make([]byte, 8, 8+len(body)+1)
+		copy(buf, id)
+		binary.LittleEndian.PutUint32(buf[4:], uint32(len(body)))
+		buf = append(buf, body...)
+		if len(body)%2 == 1 {
+			buf = append(buf, 0)
+		}
+		return buf
+	}
+	// WAVEFORMATEX with MPEGLAYER3WAVEFORMAT's extra bytes.
+	format := make([]byte, 30)
+	binary.LittleEndian.PutUint16(format, 0x0055)
+	binary.LittleEndian.PutUint16(format[2:], 1)
+	binary.LittleEndian.PutUint32(format[4:], 22050)
+
+	body := []byte("WAVE")
+	body = append(body, chunk("fmt ", format)...)
+	body = append(body, chunk("fact", make([]byte, 4))...)
+	body = append(body, chunk("data", audio)...)
+	// A chunk after the data chunk looks like frame headers.
+	body = append(body, chunk("junk", bytes.Repeat([]byte("\xff\xfe\x90\x00"), 16))...)
+	buf := chunk("RIFF", body)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Length(), int64(len(want)); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+
+	// A RIFF/WAVE file with PCM is rejected.
+	binary.LittleEndian.PutUint16(buf[20:], 0x0001)
+	if _, err := mp3.NewDecoder(bytes.NewReader(buf)); err == nil {
+		t.Errorf("NewDecoder must return an error for PCM in RIFF/WAVE")
+	}
+}
+
+func TestNonMPEGFormat(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Input  []byte
+		Format string
+	}{
+		{
+			Name:   "ADTS",
+			Input:  append([]byte{0xff, 0xf1, 0x50, 0x80, 0x02, 0x1f, 0xfc}, make([]byte, 1024)...),
+			Format: "AAC-ADTS",
+		},
+		{
+			Name:   "FLAC",
+			Input:  append([]byte("fLaC"), make([]byte, 1024)...),
+			Format: "FLAC",
+		},
+		{
+			Name:   "MP4",
+			Input:  append([]byte("\x00\x00\x00\x20ftypM4A "), make([]byte, 1024)...),
+			Format: "MP4",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := mp3.NewDecoder(bytes.NewReader(c.Input))
+			var ferr *mp3.FormatError
+			if !errors.As(err, &ferr) {
+				t.Fatalf("got: %v, want: *mp3.FormatError", err)
+			}
+			if ferr.Format != c.Format {
+				t.Errorf("Format: got: %q, want: %q", ferr.Format, c.Format)
+			}
+		})
+	}
+}
+
+// splitMPEG2Frames splits the audio of example/mpeg2.mp3 (MPEG-2 Layer III, 22050 Hz) into frames.
+func splitMPEG2Frames(t testing.TB) [][]byte {
+	t.Helper()
+	bitrates := []int{0, 8000, 16000, 24000, 32000, 40000, 48000, 56000, 64000, 80000, 96000, 112000, 128000, 144000, 160000}
+	buf := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+	var frames [][]byte
+	for len(buf) >= 4 {
+		if buf[0] != 0xff || buf[1]&0xe0 != 0xe0 {
+			t.Fatalf("frame header is not found: % x", buf[:4])
+		}
+		size := 72*bitrates[buf[2]>>4]/22050 + int(buf[2]>>1)&1
+		if size > len(buf) {
+			break
+		}
+		frames = append(frames, buf[:size])
+		buf = buf[size:]
+	}
+	return frames
+}
+
+func TestAcceptReservedEmphasis(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	// Set the reserved emphasis value 2 to all the frames.
+	var src []byte
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for _, f := range splitMPEG2Frames(t) {
+		f = append([]byte{}, f...)
+		f[3] = f[3]&^0x03 | 0x02
+		src = append(src, f...)
+	}
+
+	if d, err := mp3.NewDecoder(bytes.NewReader(src)); err == nil {
+		if got, err := ioutil.ReadAll(d); err == nil && bytes.Equal(got, want) {
+			t.Errorf("frames with the reserved emphasis must not be decoded by default")
+		}
+	}
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		AcceptReservedEmphasis: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
+
+// corruptedMPEG2 returns example/mpeg2.mp3 whose frame at index has an invalid big_values in the side information.
+func corruptedMPEG2(t testing.TB, index int) []byte {
+	t.Helper()
+	var src []byte
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for i, f := range splitMPEG2Frames(t) {
+		if i == index {
+			f = append([]byte{}, f...)
+			// big_values of the first granule is at bits 21-29 of the side information.
+			f[4+2] |= 0x07
+			f[4+3] |= 0xfc
+		}
+		src = append(src, f...)
+	}
+	return src
+}
+
+func TestFrameError(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	const index = 100
+	const frameSize = 576 * 4
+	src := corruptedMPEG2(t, index)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err == nil {
+		t.Errorf("ReadAll with FrameErrorAbort must return an error")
+	}
+
+	for _, policy := range []mp3.FrameErrorPolicy{mp3.FrameErrorSkip, mp3.FrameErrorSilence, mp3.FrameErrorRepeat} {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			FrameError: policy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := d.Stats().BadFrames, int64(1); got != want {
+			t.Errorf("BadFrames with policy %d: got: %d, want: %d", policy, got, want)
+		}
+		wantLen := len(want)
+		if policy == mp3.FrameErrorSkip {
+			wantLen -= frameSize
+		}
+		if len(got) != wantLen {
+			t.Fatalf("decoded length with policy %d: got: %d, want: %d", policy, len(got), wantLen)
+		}
+		bad := index * frameSize
+		if !bytes.Equal(got[:bad], want[:bad]) {
+			t.Errorf("decoded PCM before the bad frame with policy %d doesn't match", policy)
+		}
+		switch policy {
+		case mp3.FrameErrorSilence:
+			if !bytes.Equal(got[bad:bad+frameSize], make([]byte, frameSize)) {
+				t.Errorf("the bad frame must be silent")
+			}
+		case mp3.FrameErrorRepeat:
+			if !bytes.Equal(got[bad:bad+frameSize], got[bad-frameSize:bad]) {
+				t.Errorf("the bad frame must repeat the previous frame")
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	layer2 := make([]byte, 417*4)
+	for i := 0; i < 4; i++ {
+		// MPEG-1 Layer II, 128 kbps, 44100 Hz, stereo
+		binary.BigEndian.PutUint32(layer2[417*i:], 0xfffd9000)
+	}
+	freeBitrate := make([]byte, 1024)
+	binary.BigEndian.PutUint32(freeBitrate, 0xfffb0000)
+	reservedVersion := make([]byte, 417*4)
+	for i := 0; i < 4; i++ {
+		// The reserved version, Layer III, 128 kbps
+		binary.BigEndian.PutUint32(reservedVersion[417*i:], 0xffeb9000)
+	}
+
+	cases := []struct {
+		Name  string
+		Input []byte
+		Err   error
+	}{
+		{
+			Name:  "layer II",
+			Input: layer2,
+			Err:   mp3.ErrUnsupportedLayer,
+		},
+		{
+			Name:  "free bitrate",
+			Input: freeBitrate,
+			Err:   mp3.ErrFreeBitrate,
+		},
+		{
+			Name:  "reserved version",
+			Input: reservedVersion,
+			Err:   mp3.ErrUnsupportedVersion,
+		},
+		{
+			Name:  "side info",
+			Input: corruptedMPEG2(t, 0),
+			Err:   mp3.ErrInvalidSideInfo,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := mp3.NewDecoder(bytes.NewReader(c.Input))
+			if !errors.Is(err, c.Err) {
+				t.Errorf("got: %v, want: %v", err, c.Err)
+			}
+			var se *mp3.SourceError
+			if errors.As(err, &se) {
+				t.Errorf("a format error must not be a source error: %v", err)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+// failingReader fails with err after n bytes are read.
+type failingReader struct {
+	r   io.Reader
+	n   int
+	err error
+}
+
+func (f *failingReader) Read(buf []byte) (int, error) {
+	if f.n <= 0 {
+		return 0, f.err
+	}
+	if len(buf) > f.n {
+		buf = buf[:f.n]
+	}
+	n, err := f.r.Read(buf)
+	f.n -= n
+	return n, err
+}
+
+func TestSourceError(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+	d, err := mp3.NewDecoderWithOptions(&failingReader{
+		r:   bytes.NewReader(src),
+		n:   len(src) / 2,
+		err: timeoutError{},
+	}, &mp3.DecoderOptions{
+		// A source error must not be concealed as a bad frame.
+		FrameError: mp3.FrameErrorSkip,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ioutil.ReadAll(d)
+	if !errors.Is(err, timeoutError{}) {
+		t.Errorf("got: %v, want: %v", err, timeoutError{})
+	}
+	var se *mp3.SourceError
+	if !errors.As(err, &se) || se.Op != "read" {
+		t.Errorf("got: %v, want: a source error on read", err)
+	}
+	var ne interface{ Timeout() bool }
+	if !errors.As(err, &ne) || !ne.Timeout() {
+		t.Errorf("got: %v, want: a timeout error", err)
+	}
+	if got := d.Stats().BadFrames; got != 0 {
+		t.Errorf("BadFrames: got: %d, want: 0", got)
+	}
+}
+
+// countingReader counts the calls of Read.
+type countingReader struct {
+	r     io.Reader
+	reads int
+}
+
+func (c *countingReader) Read(buf []byte) (int, error) {
+	c.reads++
+	return c.r.Read(buf)
+}
+
+func TestReadAhead(t *testing.T) {
+	for _, seekable := range []bool{false, true} {
+		var r io.Reader
+		c := &countingReader{r: bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3"))}
+		if seekable {
+			// The stream is scanned for the length before decoding.
+			r = struct {
+				io.Reader
+				io.Seeker
+			}{c, c.r.(io.Seeker)}
+		} else {
+			r = c
+		}
+		d, err := mp3.NewDecoder(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, decodeAll(t, "example/mpeg2.mp3", nil)) {
+			t.Errorf("seekable: %v: decoded PCM doesn't match", seekable)
+		}
+		// The small reads like the frame headers are served from the bytes read ahead.
+		if c.reads > mpeg2Frames/4 {
+			t.Errorf("seekable: %v: Read is called %d times for %d frames", seekable, c.reads, mpeg2Frames)
+		}
+	}
+}
+
+func TestPositionError(t *testing.T) {
+	const index = 100
+	src := corruptedMPEG2(t, index)
+	offset := int64(mpeg2AudioStart)
+	for _, f := range splitMPEG2Frames(t)[:index] {
+		offset += int64(len(f))
+	}
+
+	for _, seekable := range []bool{true, false} {
+		var r io.Reader = bytes.NewReader(src)
+		if !seekable {
+			r = struct{ io.Reader }{r}
+		}
+		d, err := mp3.NewDecoder(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ioutil.ReadAll(d)
+		var perr *mp3.PositionError
+		if !errors.As(err, &perr) {
+			t.Fatalf("seekable: %v: got: %v, want: *mp3.PositionError", seekable, err)
+		}
+		if perr.Offset != offset || perr.FrameIndex != index {
+			t.Errorf("seekable: %v: got: offset %d, frame %d, want: offset %d, frame %d", seekable, perr.Offset, perr.FrameIndex, offset, index)
+		}
+		if !errors.Is(err, mp3.ErrInvalidSideInfo) {
+			t.Errorf("seekable: %v: got: %v, want: %v", seekable, err, mp3.ErrInvalidSideInfo)
+		}
+	}
+}
+
+func TestMaxResyncBytes(t *testing.T) {
+	src := append(make([]byte, 65536), mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+
+	if _, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		MaxResyncBytes: 1024,
+	}); !errors.Is(err, mp3.ErrNoSync) {
+		t.Errorf("got: %v, want: %v", err, mp3.ErrNoSync)
+	}
+
+	if _, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		MaxResyncBytes: 65536,
+	}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStrict(t *testing.T) {
+	strict := &mp3.DecoderOptions{
+		Strict: true,
+	}
+	if got, want := decodeAll(t, "example/mpeg2.mp3", strict), decodeAll(t, "example/mpeg2.mp3", nil); !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match in the strict mode")
+	}
+
+	frames := splitMPEG2Frames(t)
+	// Find a frame whose main data refers back to the previous frames.
+	cut := 1
+	for frames[cut][4] == 0 {
+		cut++
+	}
+	var cutSrc []byte
+	for _, f := range frames[cut:] {
+		cutSrc = append(cutSrc, f...)
+	}
+
+	cases := []struct {
+		Name  string
+		Input []byte
+		Err   error
+	}{
+		{
+			Name:  "resync",
+			Input: append(make([]byte, 16), mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...),
+			Err:   mp3.ErrResync,
+		},
+		{
+			Name:  "CRC",
+			Input: protectedFrames(10, 3),
+			Err:   mp3.ErrCRCMismatch,
+		},
+		{
+			Name:  "reservoir",
+			Input: cutSrc,
+			Err:   mp3.ErrReservoirUnderflow,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			d, err := mp3.NewDecoderWithOptions(bytes.NewReader(c.Input), strict)
+			if err == nil {
+				_, err = ioutil.ReadAll(d)
+			}
+			if !errors.Is(err, c.Err) {
+				t.Errorf("got: %v, want: %v", err, c.Err)
+			}
+		})
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Err(); err != nil {
+		t.Errorf("Err: got: %v, want: nil", err)
+	}
+
+	d, err = mp3.NewDecoder(bytes.NewReader(src[:len(src)-100]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Err(); !errors.Is(err, mp3.ErrTruncated) {
+		t.Errorf("Err: got: %v, want: %v", err, mp3.ErrTruncated)
+	}
+}
+
+func TestPartialFinalFrame(t *testing.T) {
+	frames := splitMPEG2Frames(t)
+	src := append([]byte{}, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for _, f := range frames[:100] {
+		src = append(src, f...)
+	}
+	last := frames[100]
+	src = append(src, last[:len(last)/2]...)
+
+	decode := func(partial bool) ([]byte, error) {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			PartialFinalFrame: partial,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pcm, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pcm, d.Err()
+	}
+
+	dropped, err := decode(false)
+	if !errors.Is(err, mp3.ErrTruncated) {
+		t.Errorf("Err: got: %v, want: %v", err, mp3.ErrTruncated)
+	}
+	got, err := decode(true)
+	if !errors.Is(err, mp3.ErrTruncated) {
+		t.Errorf("Err: got: %v, want: %v", err, mp3.ErrTruncated)
+	}
+
+	// MPEG-2 has 576 samples per frame.
+	const frameSize = 576 * 4
+	if len(got) != len(dropped)+frameSize {
+		t.Fatalf("length: got: %d, want: %d", len(got), len(dropped)+frameSize)
+	}
+	if !bytes.Equal(got[:len(dropped)], dropped) {
+		t.Errorf("the frames before the partial frame must be the same")
+	}
+	if bytes.Equal(got[len(dropped):], make([]byte, frameSize)) {
+		t.Errorf("the partial frame must not be silent")
+	}
+}
+
+func TestMemoryLimit(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	decode := func(limit mp3.MemoryLimit) error {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			MemoryLimit: limit,
+			// The memory limit must not be bypassed by the policy.
+			FrameError: mp3.FrameErrorSkip,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = ioutil.ReadAll(d)
+		return err
+	}
+
+	for _, tc := range []struct {
+		limit mp3.MemoryLimit
+		ok    bool
+	}{
+		{limit: mp3.MemoryLimit{MaxIndexEntries: mpeg2Frames}, ok: true},
+		{limit: mp3.MemoryLimit{MaxIndexEntries: mpeg2Frames - 1}},
+		{limit: mp3.MemoryLimit{MaxBufferedBytes: 576 * 4}, ok: true},
+		{limit: mp3.MemoryLimit{MaxBufferedBytes: 576*4 - 1}},
+		{limit: mp3.MemoryLimit{MaxReservoirBytes: 511}, ok: true},
+		{limit: mp3.MemoryLimit{MaxReservoirBytes: 1}},
+	} {
+		err := decode(tc.limit)
+		if tc.ok && err != nil {
+			t.Errorf("limit %+v: got: %v, want: nil", tc.limit, err)
+		}
+		if !tc.ok && !errors.Is(err, mp3.ErrMemoryLimit) {
+			t.Errorf("limit %+v: got: %v, want: %v", tc.limit, err, mp3.ErrMemoryLimit)
+		}
+	}
+}
+
+func TestSyncConfirmations(t *testing.T) {
+	frames := splitMPEG2Frames(t)
+
+	// The junk has a false frame header.
+	junk := make([]byte, 64)
+	copy(junk[10:], frames[0][:4])
+
+	src := append([]byte{}, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for i, f := range frames {
+		if i == 50 {
+			src = append(src, junk...)
+		}
+		src = append(src, f...)
+	}
+
+	decode := func(confirmations int) ([]byte, []int64, error) {
+		var resyncs []int64
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			SyncConfirmations: confirmations,
+			OnResync: func(skippedBytes int64) {
+				resyncs = append(resyncs, skippedBytes)
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		pcm, err := ioutil.ReadAll(d)
+		return pcm, resyncs, err
+	}
+
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	if got, _, err := decode(0); err == nil && bytes.Equal(got, want) {
+		t.Fatalf("the false frame header must be accepted without confirmations")
+	}
+
+	got, resyncs, err := decode(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("the false frame header must be skipped")
+	}
+	if len(resyncs) != 1 || resyncs[0] != int64(len(junk)) {
+		t.Errorf("OnResync: got: %v, want: [%d]", resyncs, len(junk))
+	}
+}
+
+func TestNoValidFrames(t *testing.T) {
+	text := bytes.Repeat([]byte("This is not an MP3 file.\n"), 40)
+	for _, src := range [][]byte{
+		text,
+		append(id3v2Header(100), make([]byte, 100)...),
+	} {
+		_, err := mp3.NewDecoder(bytes.NewReader(src))
+		if !errors.Is(err, mp3.ErrNoValidFrames) {
+			t.Errorf("got: %v, want: %v", err, mp3.ErrNoValidFrames)
+			continue
+		}
+		var e *mp3.NoValidFramesError
+		if !errors.As(err, &e) {
+			t.Fatalf("got: %T, want: *mp3.NoValidFramesError", err)
+		}
+		if got, want := e.BytesScanned, int64(len(src)); got != want {
+			t.Errorf("BytesScanned: got: %d, want: %d", got, want)
+		}
+	}
+}
+
+func TestOutputChecksum(t *testing.T) {
+	got := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	// The output must be bit-identical on all platforms.
+	if sum := crc32.ChecksumIEEE(got); sum != outputChecksum {
+		t.Errorf("checksum of the output: got: %08x, want: %08x", sum, outputChecksum)
+	}
+}
+
+// id3v2Header returns an ID3v2.3 header declaring the tag size.
+func id3v2Header(size int) []byte {
+	return []byte{'I', 'D', '3', 3, 0, 0, byte(size>>21) & 0x7f, byte(size>>14) & 0x7f, byte(size>>7) & 0x7f, byte(size) & 0x7f}
+}
+
+func TestHugeID3v2(t *testing.T) {
+	// A tag declaring 200 MiB in a short stream must not allocate the declared size.
+	src := append(id3v2Header(200<<20), make([]byte, 1024)...)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if _, err := mp3.NewDecoder(bytes.NewReader(src)); err == nil {
+		t.Errorf("NewDecoder must fail with a truncated tag")
+	}
+	runtime.ReadMemStats(&after)
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 1<<20 {
+		t.Errorf("allocated %d bytes for the truncated tag", alloc)
+	}
+
+	// A tag larger than the limit to keep is skipped.
+	const size = 17 << 20
+	src = append(id3v2Header(size), make([]byte, size)...)
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := decodeAll(t, "example/mpeg2.mp3", nil); !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
+
+func TestManyID3v2(t *testing.T) {
+	// Many empty tags must be skipped in linear time without recursion. The tags beyond the limit are skipped as
+	// garbage before the first frame.
+	const n = 100000
+	src := bytes.Repeat(id3v2Header(0), n)
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(d.RawTags()), 1024; got != want {
+		t.Errorf("number of the raw tags: got: %d, want: %d", got, want)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := decodeAll(t, "example/mpeg2.mp3", nil); !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
+
+func TestReservoirUnderflow(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	frames := splitMPEG2Frames(t)
+	// Cut the stream at a frame whose main data refers back to the previous frames.
+	cut := 1
+	for frames[cut][4] == 0 {
+		cut++
+	}
+	var src []byte
+	for _, f := range frames[cut:] {
+		src = append(src, f...)
+	}
+
+	var underflows int
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		OnWarning: func(w mp3.Warning) {
+			if w.Kind == mp3.WarningReservoirUnderflow {
+				underflows++
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if underflows == 0 {
+		t.Errorf("no reservoir underflow is reported")
+	}
+
+	const frameSize = 576 * 4
+	if len(got) != len(want)-cut*frameSize {
+		t.Fatalf("decoded length: got: %d, want: %d", len(got), len(want)-cut*frameSize)
+	}
+	// The underflowing frames are silent.
+	if !bytes.Equal(got[:frameSize], make([]byte, frameSize)) {
+		t.Errorf("the first frame must be silent")
+	}
+	// After the bit reservoir and the synthesis state are filled, the output matches.
+	const settled = 10
+	if !bytes.Equal(got[settled*frameSize:], want[(cut+settled)*frameSize:]) {
+		t.Errorf("decoded PCM after %d frames doesn't match", settled)
+	}
+}
+
+func TestSeekWithoutPanic(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	d, err := mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(src)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Seek(1000, io.SeekStart); err == nil {
+		t.Errorf("Seek on a non-seekable source must fail")
+	}
+
+	d, err = mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, offset := range []int64{d.Length(), d.Length() + 4096, 1 << 40} {
+		if _, err := d.Seek(offset, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := d.Read(make([]byte, 4096)); err != io.EOF {
+			t.Errorf("Read after seeking to %d: got: %v, want: io.EOF", offset, err)
+		}
+	}
+}
+
+func TestResyncAfterCorruptedHeader(t *testing.T) {
+	const n = 20
+	const bad = 5
+	// MPEG-2 Layer III, 64 kbps, 22050 Hz, mono without CRC
+	const size = 72 * 64000 / 22050
+
+	var src []byte
+	for i := 0; i < n; i++ {
+		f := make([]byte, size)
+		binary.BigEndian.PutUint32(f, 0xfff380c0)
+		// The side information and the main data are all zero, and the frame is silent.
+		if i == bad {
+			// Corrupt the bitrate to 160 kbps so that the frame size covers the following frames.
+			f[2] = 0xe0
+			// part2_3_length = 4095, big_values = 288 and table_select = 23 so that the main data overruns.
+			var v uint64
+			v = v<<8 | 0     // main_data_begin
+			v = v<<1 | 0     // private_bits
+			v = v<<12 | 4095 // part2_3_length
+			v = v<<9 | 288   // big_values
+			v = v<<8 | 0     // global_gain
+			v = v<<9 | 0     // scalefac_compress
+			v = v<<1 | 0     // window_switching_flag
+			v = v<<5 | 23    // table_select[0]
+			v = v<<5 | 23    // table_select[1]
+			v = v<<5 | 23    // table_select[2]
+			v <<= 64 - 63
+			binary.BigEndian.PutUint64(f[4:], v)
+		}
+		src = append(src, f...)
+	}
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		FrameError: mp3.FrameErrorSilence,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Stats().BadFrames, int64(1); got != want {
+		t.Errorf("BadFrames: got: %d, want: %d", got, want)
+	}
+	// No good frame is lost.
+	if got, want := len(got), n*576*4; got != want {
+		t.Errorf("decoded length: got: %d, want: %d", got, want)
+	}
+}
+
+// readWithBuffer reads d to the end with Read calls with a buffer of size bytes.
+func readWithBuffer(t *testing.T, d *mp3.Decoder, size int) []byte {
+	t.Helper()
+	var out []byte
+	buf := make([]byte, size)
+	for {
+		n, err := d.Read(buf)
+		out = append(out, buf[:n]...)
+		// Overwrite the buffer to check that the decoder doesn't keep it.
+		for i := range buf {
+			buf[i] = 0xff
+		}
+		if err == io.EOF {
+			return out
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadDirect(t *testing.T) {
+	for _, src := range []struct {
+		name string
+		buf  []byte
+	}{
+		{"classic.mp3", mustReadFile(t, "example/classic.mp3")},
+		{"gapless", mpeg2WithLAME(t, 576, 1104)},
+	} {
+		d, err := mp3.NewDecoder(bytes.NewReader(src.buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// A buffer smaller than a frame doesn't take the direct path.
+		want := readWithBuffer(t, d, 1000)
+
+		// 4608 bytes is the size of a frame of classic.mp3.
+		for _, size := range []int{4608, 10000} {
+			d, err := mp3.NewDecoder(bytes.NewReader(src.buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readWithBuffer(t, d, size); !bytes.Equal(got, want) {
+				t.Errorf("%s, %d-byte buffer: decoded PCM doesn't match (%d bytes, want %d bytes)", src.name, size, len(got), len(want))
+			}
+		}
+	}
+}
+
+func TestScanAllocs(t *testing.T) {
+	buf := mustReadFile(t, "example/classic.mp3")
+	// The scan reads only the frame headers, so the allocations don't depend on the number of the frames.
+	n := testing.AllocsPerRun(5, func() {
+		if _, err := mp3.NewDecoder(bytes.NewReader(buf)); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if n > 200 {
+		t.Errorf("allocations in NewDecoder: got: %v, want: <= 200", n)
+	}
+}
+
+func TestQualityFast(t *testing.T) {
+	accurate := decodeAll(t, "example/mpeg2.mp3", nil)
+	fast := decodeAll(t, "example/mpeg2.mp3", &mp3.DecoderOptions{Quality: mp3.QualityFast})
+	if len(fast) != len(accurate) {
+		t.Fatalf("length: got: %d, want: %d", len(fast), len(accurate))
+	}
+
+	sample := func(pcm []byte, i int) float64 {
+		return float64(int16(binary.LittleEndian.Uint16(pcm[2*i:])))
+	}
+	// The fast mode keeps the lower half of the band, and the higher frequencies are lost. The energy of the
+	// differences of adjacent samples is a rough measure of the high frequencies.
+	var signal, noise, accurateHigh, fastHigh float64
+	for i := 2; i < len(accurate)/2; i++ {
+		a, f := sample(accurate, i), sample(fast, i)
+		signal += a * a
+		noise += (a - f) * (a - f)
+		d := a - sample(accurate, i-2)
+		accurateHigh += d * d
+		d = f - sample(fast, i-2)
+		fastHigh += d * d
+	}
+	if snr := 10 * math.Log10(signal/noise); snr < 8 {
+		t.Errorf("SNR: got: %f dB, want: >= 8 dB", snr)
+	}
+	if r := fastHigh / accurateHigh; r > 0.6 {
+		t.Errorf("ratio of the high frequencies: got: %f, want: <= 0.6", r)
+	}
+}
+
+func TestFramesPerRead(t *testing.T) {
+	buf := mustReadFile(t, "example/classic.mp3")
+	want := decodeAll(t, "example/classic.mp3", nil)
+
+	// 4608 bytes is the size of a frame of classic.mp3.
+	const bytesPerFrame = 4608
+	for _, size := range []int{3 * bytesPerFrame, 3*bytesPerFrame + 1000, 2*bytesPerFrame + 1000} {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{FramesPerRead: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		frames := size / bytesPerFrame
+		if frames > 3 {
+			frames = 3
+		}
+		var got []byte
+		p := make([]byte, size)
+		for {
+			n, err := d.Read(p)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, p[:n]...)
+			if len(got) < len(want) && n != frames*bytesPerFrame {
+				t.Errorf("%d-byte buffer: Read returned %d bytes, want: %d", size, n, frames*bytesPerFrame)
+			}
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%d-byte buffer: decoded PCM doesn't match (%d bytes, want %d bytes)", size, len(got), len(want))
+		}
+	}
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{FramesPerRead: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Read(make([]byte, 1000)); err != io.ErrShortBuffer {
+		t.Errorf("Read with a buffer smaller than a frame: got: %v, want: %v", err, io.ErrShortBuffer)
+	}
+	// The frame is kept for the next Read.
+	p := make([]byte, bytesPerFrame)
+	n, err := d.Read(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(p[:n], want[:bytesPerFrame]) {
+		t.Errorf("the first frame doesn't match")
+	}
+}
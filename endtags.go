@@ -0,0 +1,192 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"encoding/binary"
+	"io"
+	"strconv"
+)
+
+const (
+	// lyrics3v2FooterSize is the size of the Lyrics3 v2.00 footer, which is the 6-digit size and "LYRICS200".
+	lyrics3v2FooterSize = 15
+)
+
+// tagReader reads the tags in a source via io.ReaderAt.
+type tagReader struct {
+	r io.ReaderAt
+}
+
+func (t tagReader) readAt(offset, n int64) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := t.r.ReadAt(buf, offset); err != nil {
+		return nil, &SourceError{Op: "read", Err: err}
+	}
+	return buf, nil
+}
+
+// tag returns the tag block of the format in [offset, offset+size).
+// The data is not read if the tag is too large to be kept.
+func (t tagReader) tag(format TagFormat, offset, size int64) (tagBlock, error) {
+	b := tagBlock{
+		format: format,
+		offset: offset,
+		size:   size,
+	}
+	if size <= maxID3v2Size {
+		data, err := t.readAt(offset, size)
+		if err != nil {
+			return tagBlock{}, err
+		}
+		b.data = data
+	}
+	return b, nil
+}
+
+// endTags reads the tags ending at end and not preceding start, and returns the tags in the reverse order of their
+// offsets and the start of the tags, which is the end of the audio data.
+//
+// The ID3v1 tag, the APE tags, the Lyrics3 v2.00 tags and the ID3v2.4 tags with footers are detected in any order.
+func (t tagReader) endTags(start, end int64) ([]tagBlock, int64, error) {
+	var tags []tagBlock
+	add := func(format TagFormat, size int64) error {
+		b, err := t.tag(format, end-size, size)
+		if err != nil {
+			return err
+		}
+		tags = append(tags, b)
+		end -= size
+		return nil
+	}
+
+	for {
+		if end-start >= id3v1Size {
+			b, err := t.readAt(end-id3v1Size, 3)
+			if err != nil {
+				return nil, 0, err
+			}
+			if string(b) == "TAG" {
+				if err := add(TagFormatID3v1, id3v1Size); err != nil {
+					return nil, 0, err
+				}
+				continue
+			}
+		}
+		if end-start >= apeFooterSize {
+			f, err := t.readAt(end-apeFooterSize, apeFooterSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			if string(f[:8]) == "APETAGEX" {
+				// The size includes the footer and excludes the header.
+				n := int64(binary.LittleEndian.Uint32(f[12:16]))
+				if binary.LittleEndian.Uint32(f[20:24])&apeFlagHasHeader != 0 {
+					n += apeFooterSize
+				}
+				if n >= apeFooterSize && end-n >= start {
+					if err := add(TagFormatAPE, n); err != nil {
+						return nil, 0, err
+					}
+					continue
+				}
+			}
+		}
+		if end-start >= lyrics3v2FooterSize {
+			f, err := t.readAt(end-lyrics3v2FooterSize, lyrics3v2FooterSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			if string(f[6:]) == "LYRICS200" {
+				// The size excludes the footer.
+				n, err := strconv.Atoi(string(f[:6]))
+				if err == nil && n > 0 && end-int64(n)-lyrics3v2FooterSize >= start {
+					if err := add(TagFormatLyrics3, int64(n)+lyrics3v2FooterSize); err != nil {
+						return nil, 0, err
+					}
+					continue
+				}
+			}
+		}
+		if end-start >= 2*id3v2HeaderSize {
+			f, err := t.readAt(end-id3v2HeaderSize, id3v2HeaderSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			if string(f[:3]) == "3DI" {
+				// The footer is the same as the header except for the identifier.
+				copy(f, "ID3")
+				n, ok := id3v2TagSize(f)
+				if ok && end-n >= start {
+					if err := add(TagFormatID3v2, n); err != nil {
+						return nil, 0, err
+					}
+					continue
+				}
+			}
+		}
+		return tags, end, nil
+	}
+}
+
+// id3v2TagSize returns the size of the ID3v2 tag including the header and the footer from the header h.
+func id3v2TagSize(h []byte) (int64, bool) {
+	if string(h[:3]) != "ID3" || h[3] == 0xff || h[4] == 0xff {
+		return 0, false
+	}
+	if (h[6]|h[7]|h[8]|h[9])&0x80 != 0 {
+		return 0, false
+	}
+	n := int64(h[6])<<21 | int64(h[7])<<14 | int64(h[8])<<7 | int64(h[9])
+	n += id3v2HeaderSize
+	if h[3] >= 4 && h[5]&id3v2FlagFooter != 0 {
+		n += id3v2HeaderSize
+	}
+	return n, true
+}
+
+// excludeEndTags finds the tags at the end of the source r of size bytes and keeps them. Then, excludeEndTags limits
+// the audio data so that the tags are not scanned or decoded as audio frames.
+//
+// start is the start of the audio data. If the end of the audio data is already known, e.g. in a RIFF container,
+// excludeEndTags does nothing.
+func (s *source) excludeEndTags(r io.ReaderAt, start, size int64) error {
+	if s.end != 0 {
+		return nil
+	}
+	tags, end, err := tagReader{r: r}.endTags(start, size)
+	if err != nil {
+		return err
+	}
+	for i := len(tags) - 1; i >= 0; i-- {
+		s.addTrailing(tags[i])
+	}
+	if end < size {
+		s.end = end
+	}
+	return nil
+}
+
+// readSeekerAt is io.ReaderAt implemented by seeking an io.ReadSeeker. ReadAt changes the position of the reader.
+type readSeekerAt struct {
+	r io.ReadSeeker
+}
+
+func (r readSeekerAt) ReadAt(buf []byte, offset int64) (int, error) {
+	if _, err := r.r.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(r.r, buf)
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+)
+
+// The errors classifying decode failures.
+// The errors returned by Decoder wrap them with details like the position, so use errors.Is to check them.
+var (
+	// ErrUnsupportedLayer is the error when a frame is MPEG audio Layer I or II.
+	ErrUnsupportedLayer = consts.ErrUnsupportedLayer
+
+	// ErrUnsupportedVersion is the error when the stream has no valid frames and starts with a frame header of the
+	// reserved MPEG version.
+	ErrUnsupportedVersion = consts.ErrUnsupportedVersion
+
+	// ErrFreeBitrate is the error when a frame is in the free bitrate format.
+	ErrFreeBitrate = consts.ErrFreeBitrate
+
+	// ErrInvalidHeader is the error when a frame header has a value the decoder can't handle.
+	ErrInvalidHeader = consts.ErrInvalidHeader
+
+	// ErrInvalidSideInfo is the error when the side information of a frame has an invalid value.
+	ErrInvalidSideInfo = consts.ErrInvalidSideInfo
+
+	// ErrInvalidMainData is the error when the main data of a frame, e.g. its Huffman codes, is corrupted.
+	ErrInvalidMainData = consts.ErrInvalidMainData
+
+	// ErrTruncated is the error when the stream ends in the middle of a frame.
+	ErrTruncated = consts.ErrTruncated
+
+	// ErrNoSync is the error when no valid frame header is found within DecoderOptions.MaxResyncBytes.
+	ErrNoSync = consts.ErrNoSync
+
+	// ErrResync is the error when bytes are skipped to find a valid frame header in the strict mode.
+	ErrResync = consts.ErrResync
+
+	// ErrReservoirUnderflow is the error when the main data of a frame refers back to more bytes than the bit
+	// reservoir has in the strict mode.
+	ErrReservoirUnderflow = consts.ErrReservoirUnderflow
+
+	// ErrMemoryLimit is the error when decoding needs more memory than DecoderOptions.MemoryLimit allows.
+	ErrMemoryLimit = consts.ErrMemoryLimit
+
+	// ErrNoValidFrames is the error when the input has no valid MPEG audio frames, e.g. the input is an image or a
+	// text. The error is returned as a *NoValidFramesError.
+	ErrNoValidFrames = errors.New("mp3: no valid MPEG audio frames")
+)
+
+// SourceError is an error returned by the source, e.g. a network timeout, as opposed to an error in the format of
+// the stream. Use errors.As to tell them apart, e.g. to retry only on source errors.
+type SourceError struct {
+	// Op is the operation on the source, "read" or "seek".
+	Op string
+
+	// Err is the error returned by the source.
+	Err error
+}
+
+// Error implements error.
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("mp3: source %s failed: %v", e.Op, e.Err)
+}
+
+// Unwrap returns the error returned by the source.
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// NoValidFramesError is returned when no valid MPEG audio frame is found in the input.
+type NoValidFramesError struct {
+	// BytesScanned is the number of bytes read from the input to find a frame, including tags.
+	BytesScanned int64
+}
+
+// Error implements error.
+func (e *NoValidFramesError) Error() string {
+	return fmt.Sprintf("mp3: no valid MPEG audio frames are found in %d bytes", e.BytesScanned)
+}
+
+// Unwrap returns ErrNoValidFrames.
+func (e *NoValidFramesError) Unwrap() error {
+	return ErrNoValidFrames
+}
+
+// Err returns the error why the stream ended abruptly, or nil if the stream ended cleanly or hasn't ended.
+//
+// Read returns io.EOF even when the stream is truncated in the middle of a frame, e.g. a download is cut.
+// In this case, Err returns an error wrapping ErrTruncated after Read returns io.EOF.
+// Seek resets Err.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// PositionError represents an error at a frame in the source.
+type PositionError struct {
+	// Offset is the byte offset of the frame in the source.
+	Offset int64
+
+	// FrameIndex is the index of the frame among the audio frames, or -1 if unknown.
+	// A Xing, Info or VBRI frame is not counted.
+	FrameIndex int64
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements error.
+func (e *PositionError) Error() string {
+	return fmt.Sprintf("%v (offset: %d, frame: %d)", e.Err, e.Offset, e.FrameIndex)
+}
+
+// Unwrap returns the underlying error.
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+// positionError returns a *PositionError wrapping err at the frame at offset.
+func (d *Decoder) positionError(err error, offset int64, index int64) error {
+	return &PositionError{
+		Offset:     offset,
+		FrameIndex: index,
+		Err:        err,
+	}
+}
+
+// frameIndex returns the index of the frame at offset, or -1 if unknown.
+func (d *Decoder) frameIndex(offset int64) int64 {
+	if d.scanFinished() && d.frameStarts != nil {
+		return int64(d.frameStarts.find(offset))
+	}
+	if d.seeked {
+		return -1
+	}
+	// Without seeking, the frames are read in order.
+	return d.stats.Frames
+}
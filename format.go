@@ -0,0 +1,70 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"fmt"
+	"io"
+)
+
+// FormatError is returned when the input is recognized as a format other than MPEG audio.
+type FormatError struct {
+	// Format is the name of the recognized format like "AAC-ADTS".
+	Format string
+}
+
+// Error implements error.
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("mp3: the input looks like %s, not MPEG audio", e.Format)
+}
+
+// detectFormat returns a *FormatError if s starts with the signature of a known non-MPEG format.
+// The position of s is not changed.
+func (s *source) detectFormat() error {
+	buf := make([]byte, 12)
+	n, err := s.ReadFull(buf)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	buf = buf[:n]
+	s.Unread(buf)
+
+	if f := formatName(buf); f != "" {
+		return &FormatError{Format: f}
+	}
+	return nil
+}
+
+// formatName returns the name of the format whose signature buf starts with, or an empty string if unknown.
+func formatName(buf []byte) string {
+	switch {
+	case len(buf) >= 2 && buf[0] == 0xff && buf[1]&0xf6 == 0xf0:
+		// The ADTS sync word is the same as MPEG audio's, but the layer is 0, which is reserved in MPEG audio.
+		return "AAC-ADTS"
+	case len(buf) >= 4 && string(buf[:4]) == "fLaC":
+		return "FLAC"
+	case len(buf) >= 4 && string(buf[:4]) == "OggS":
+		return "Ogg"
+	case len(buf) >= 4 && string(buf[:4]) == "\x1a\x45\xdf\xa3":
+		return "Matroska/WebM"
+	case len(buf) >= 8 && string(buf[4:8]) == "ftyp":
+		return "MP4"
+	case len(buf) >= 12 && string(buf[:4]) == "FORM" && (string(buf[8:12]) == "AIFF" || string(buf[8:12]) == "AIFC"):
+		return "AIFF"
+	case len(buf) >= 6 && string(buf[:6]) == "#!AMR\n":
+		return "AMR"
+	}
+	return ""
+}
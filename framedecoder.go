@@ -0,0 +1,75 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+
+	"github.com/hajimehoshi/go-mp3/internal/frame"
+)
+
+// A FrameStreamDecoder decodes frames pushed one by one, e.g. frames demuxed from a container like MP4, Matroska
+// or RTP packets.
+//
+// FrameStreamDecoder keeps the bit reservoir and the synthesis state across the pushed frames, so the frames must
+// be pushed in the stream order.
+type FrameStreamDecoder struct {
+	frame *frame.Frame
+}
+
+// NewFrameStreamDecoder returns a new FrameStreamDecoder.
+func NewFrameStreamDecoder() *FrameStreamDecoder {
+	return &FrameStreamDecoder{}
+}
+
+// PushFrame decodes a frame including its header and returns the decoded PCM.
+//
+// The PCM is formatted in the same way as Decoder: 16bit (little endian) 2 channels.
+// The returned slice is newly allocated for each call.
+func (d *FrameStreamDecoder) PushFrame(buf []byte) ([]byte, error) {
+	f, _, err := frame.Read(&bytesSource{buf: buf}, 0, d.frame, frame.Options{})
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	d.frame = f
+	return f.Decode(), nil
+}
+
+// Reset discards the bit reservoir and the synthesis state.
+// Reset should be called when the next frame doesn't follow the last pushed frame, e.g. after seeking in the
+// container.
+func (d *FrameStreamDecoder) Reset() {
+	d.frame = nil
+}
+
+// bytesSource is a source of a byte slice.
+type bytesSource struct {
+	buf []byte
+}
+
+func (b *bytesSource) ReadFull(buf []byte) (int, error) {
+	n := copy(buf, b.buf)
+	b.buf = b.buf[n:]
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
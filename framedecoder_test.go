@@ -0,0 +1,42 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestFrameStreamDecoder(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	d := mp3.NewFrameStreamDecoder()
+	var got []byte
+	for _, f := range splitMPEG2Frames(t) {
+		pcm, err := d.PushFrame(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, pcm...)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
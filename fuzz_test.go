@@ -0,0 +1,63 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18 && !mp3minimal
+// +build go1.18,!mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// FuzzDecoder checks that no public API panics with arbitrary inputs.
+// Run it with `go test -fuzz FuzzDecoder`. Without -fuzz, the seed corpus and testdata/fuzz are run as regression
+// tests.
+func FuzzDecoder(f *testing.F) {
+	mpeg2 := mustReadFile(f, "example/mpeg2.mp3")
+	f.Add(mpeg2[:4096])
+	f.Add(corruptedMPEG2(f, 1)[:4096])
+	f.Add(protectedFrames(4, 1))
+	f.Add([]byte("ID3\x04\x00\x10\x00\x00\x00\x10"))
+	f.Add([]byte("RIFF\x00\x00\x00\x00WAVEdata\xff\xff\xff\xff\xff\xfb\x90\x00"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, policy := range []mp3.FrameErrorPolicy{mp3.FrameErrorAbort, mp3.FrameErrorSkip, mp3.FrameErrorRepeat} {
+			d, err := mp3.NewDecoderWithOptions(bytes.NewReader(data), &mp3.DecoderOptions{
+				FrameError: policy,
+			})
+			if err != nil {
+				continue
+			}
+			_, _ = io.Copy(ioutil.Discard, io.LimitReader(d, 1<<20))
+			// d.Length()/2 + 100 is likely in the middle of a frame.
+			for _, pos := range []int64{0, 4, d.Length()/2 + 100, int64(len(data)), d.Length(), d.Length() + 4} {
+				if _, err := d.Seek(pos, io.SeekStart); err != nil {
+					continue
+				}
+				_, _ = d.Read(make([]byte, 4096))
+			}
+			_, _ = d.Seek(-4, io.SeekEnd)
+			_, _ = d.Seek(0, 100)
+		}
+
+		_, _ = mp3.Verify(bytes.NewReader(data))
+		_, _ = mp3.NewFrameStreamDecoder().PushFrame(data)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+// decoderDelay is the number of samples per channel the synthesis filterbanks add at the beginning.
+const decoderDelay = 529
+
+// hasGaplessInfo reports whether the stream has the encoder delay and padding in its LAME tag.
+func (d *Decoder) hasGaplessInfo() bool {
+	l := d.info.LAME
+	return l != nil && (l.EncoderDelay != 0 || l.EncoderPadding != 0)
+}
+
+// gaplessStart returns the position in the decoded bytes where the output starts.
+func (d *Decoder) gaplessStart() int64 {
+	if !d.hasGaplessInfo() {
+		return 0
+	}
+	return int64(d.info.LAME.EncoderDelay+decoderDelay) * 4
+}
+
+// gaplessEnd returns the position in the decoded bytes where the output ends,
+// or invalidLength if the total size is not available.
+func (d *Decoder) gaplessEnd() int64 {
+	l := d.decodedLength()
+	if l == invalidLength || !d.hasGaplessInfo() {
+		return l
+	}
+	// The padding includes the decoder delay. The decoded bytes are shifted by the decoder delay.
+	end := l - int64(d.info.LAME.EncoderPadding-decoderDelay)*4
+	if end > l {
+		end = l
+	}
+	if start := d.gaplessStart(); end < start {
+		end = start
+	}
+	return end
+}
+
+// outputPosition returns the position in the output stream for the position pos in the decoded bytes.
+func (d *Decoder) outputPosition(pos int64) int64 {
+	pos -= d.gaplessStart()
+	if pos < 0 {
+		return 0
+	}
+	return pos
+}
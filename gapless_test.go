@@ -0,0 +1,101 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// mpeg2WithLAME returns example/mpeg2.mp3 with a Xing frame and a LAME tag with the given delay and padding.
+func mpeg2WithLAME(t *testing.T, delay, padding int) []byte {
+	t.Helper()
+	buf := mpeg2WithXing(t)
+	lame := buf[4+9+120:]
+	copy(lame, "LAME3.100")
+	lame[21] = byte(delay >> 4)
+	lame[22] = byte(delay<<4) | byte(padding>>8)
+	lame[23] = byte(padding)
+	return buf
+}
+
+func TestGapless(t *testing.T) {
+	const (
+		delay   = 576
+		padding = 1104
+	)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mpeg2WithXing(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err = mp3.NewDecoder(bytes.NewReader(mpeg2WithLAME(t, delay, padding)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const length = (mpeg2Frames*576 - delay - padding) * 4
+	if got, want := d.Length(), int64(length); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The encoder delay and the decoder delay are trimmed.
+	const start = (delay + 529) * 4
+	if !bytes.Equal(got, raw[start:start+length]) {
+		t.Errorf("decoded PCM doesn't match the trimmed PCM")
+	}
+
+	const offset = 1000 * 4
+	pos, err := d.Seek(offset, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos != offset {
+		t.Errorf("Seek: got: %d, want: %d", pos, offset)
+	}
+	buf := make([]byte, 576*4)
+	if _, err := io.ReadFull(d, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, got[offset:offset+len(buf)]) {
+		t.Errorf("decoded PCM after Seek doesn't match")
+	}
+
+	pos, err = d.Seek(-4, io.SeekEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pos, int64(length-4); got != want {
+		t.Errorf("Seek: got: %d, want: %d", got, want)
+	}
+	rest, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 4 {
+		t.Errorf("the number of bytes after Seek: got: %d, want: 4", len(rest))
+	}
+}
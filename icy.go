@@ -0,0 +1,148 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// icyReader removes the ICY metadata blocks interleaved in a Shoutcast or Icecast stream.
+//
+// A metadata block follows every metaInt bytes of the audio data. A block consists of a length byte, which is the
+// length of the metadata in 16 bytes, and the metadata padded with zero bytes like "StreamTitle='...';".
+type icyReader struct {
+	r       io.Reader
+	metaInt int
+
+	// remaining is the number of the audio bytes until the next metadata block.
+	remaining int
+
+	// offset is the number of the audio bytes read so far.
+	offset int64
+
+	// title is the last StreamTitle.
+	title string
+
+	// titles is the StreamTitle changes not notified yet.
+	titles []icyTitle
+}
+
+// icyTitle is a change of StreamTitle at the offset in the audio data.
+type icyTitle struct {
+	offset int64
+	title  string
+}
+
+func newICYReader(r io.Reader, metaInt int) *icyReader {
+	return &icyReader{
+		r:         r,
+		metaInt:   metaInt,
+		remaining: metaInt,
+	}
+}
+
+func (r *icyReader) Read(buf []byte) (int, error) {
+	if r.remaining == 0 {
+		if err := r.readMetadata(); err != nil {
+			return 0, err
+		}
+	}
+	if len(buf) > r.remaining {
+		buf = buf[:r.remaining]
+	}
+	n, err := r.r.Read(buf)
+	r.remaining -= n
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *icyReader) readMetadata() error {
+	var l [1]byte
+	if _, err := io.ReadFull(r.r, l[:]); err != nil {
+		return err
+	}
+	meta := make([]byte, int(l[0])*16)
+	if _, err := io.ReadFull(r.r, meta); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	r.remaining = r.metaInt
+	if title, ok := parseStreamTitle(string(meta)); ok && title != r.title {
+		r.title = title
+		r.titles = append(r.titles, icyTitle{
+			offset: r.offset,
+			title:  title,
+		})
+	}
+	return nil
+}
+
+// parseStreamTitle returns the value of StreamTitle in the ICY metadata like "StreamTitle='Artist - Title';".
+func parseStreamTitle(meta string) (string, bool) {
+	const key = "StreamTitle='"
+	i := strings.Index(meta, key)
+	if i < 0 {
+		return "", false
+	}
+	v := meta[i+len(key):]
+	// The value is terminated by "';" as the value might include a single quote.
+	j := strings.Index(v, "';")
+	if j < 0 {
+		j = strings.LastIndexByte(v, '\'')
+		if j < 0 {
+			return "", false
+		}
+	}
+	return v[:j], true
+}
+
+// setUpICY makes the decoder read the audio data from r with the ICY metadata removed.
+func (d *Decoder) setUpICY(r io.Reader, metaInt int, onStreamTitle func(title string, position time.Duration)) (io.Reader, error) {
+	d.icy = newICYReader(r, metaInt)
+	d.onStreamTitle = onStreamTitle
+	return d.icy, nil
+}
+
+// notifyStreamTitles calls OnStreamTitle for the StreamTitle changes before the frame at offset.
+// The position is where the PCM of the frame starts in the decoded stream.
+func (d *Decoder) notifyStreamTitles(offset int64) {
+	if d.icy == nil {
+		return
+	}
+	for len(d.icy.titles) > 0 && d.icy.titles[0].offset <= offset {
+		t := d.icy.titles[0]
+		d.icy.titles = d.icy.titles[1:]
+		if d.onStreamTitle == nil {
+			continue
+		}
+		rate := d.sampleRate
+		if rate == 0 {
+			rate = d.info.SampleRate
+		}
+		var pos time.Duration
+		if rate > 0 {
+			samples := (d.pos + int64(d.buf.len())) / 4
+			pos = time.Duration(samples) * time.Second / time.Duration(rate)
+		}
+		d.onStreamTitle(t.title, pos)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// icyMetadata returns an ICY metadata block with the metadata.
+func icyMetadata(meta string) []byte {
+	n := (len(meta) + 15) / 16
+	b := []byte{byte(n)}
+	b = append(b, meta...)
+	return append(b, make([]byte, n*16-len(meta))...)
+}
+
+func TestStreamTitle(t *testing.T) {
+	const metaInt = 4000
+	audio := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+
+	d, err := mp3.NewDecoder(bytes.NewReader(audio))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Interleave the metadata blocks. StreamTitle changes at the 1st and the 3rd block.
+	metas := []string{
+		"StreamTitle='Artist - It's A Song';StreamUrl='';",
+		"StreamTitle='Artist - It's A Song';StreamUrl='';",
+		"StreamTitle='Another Song';",
+	}
+	var src []byte
+	for i := 0; i*metaInt < len(audio); i++ {
+		end := (i + 1) * metaInt
+		if end > len(audio) {
+			end = len(audio)
+		}
+		src = append(src, audio[i*metaInt:end]...)
+		if end == len(audio) {
+			break
+		}
+		m := ""
+		if i < len(metas) {
+			m = metas[i]
+		}
+		src = append(src, icyMetadata(m)...)
+	}
+
+	type title struct {
+		title    string
+		position time.Duration
+	}
+	var titles []title
+	// Hide io.Seeker as an ICY stream is not seekable.
+	d, err = mp3.NewDecoderWithOptions(struct{ io.Reader }{bytes.NewReader(src)}, &mp3.DecoderOptions{
+		ICYMetaInt: metaInt,
+		OnStreamTitle: func(t string, position time.Duration) {
+			titles = append(titles, title{t, position})
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("the decoded PCM doesn't match")
+	}
+
+	// positionAt returns the position of the first frame starting at or after the offset in the audio data.
+	positionAt := func(offset int) time.Duration {
+		var pos int
+		for i, f := range splitMPEG2Frames(t) {
+			if pos >= offset {
+				return time.Duration(i*576) * time.Second / 22050
+			}
+			pos += len(f)
+		}
+		return -1
+	}
+	wantTitles := []title{
+		{"Artist - It's A Song", positionAt(metaInt)},
+		{"Another Song", positionAt(3 * metaInt)},
+	}
+	if len(titles) != len(wantTitles) {
+		t.Fatalf("got: %v, want: %v", titles, wantTitles)
+	}
+	for i := range titles {
+		if titles[i] != wantTitles[i] {
+			t.Errorf("titles[%d]: got: %v, want: %v", i, titles[i], wantTitles[i])
+		}
+	}
+}
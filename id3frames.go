@@ -0,0 +1,93 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3/internal/id3"
+)
+
+// An ID3Frame is a frame in an ID3v2 tag.
+type ID3Frame struct {
+	// ID is the frame ID like "TXXX". The 3-character IDs of ID3v2.2 are converted to the IDs of ID3v2.3 and later
+	// when there are counterparts.
+	ID string
+
+	// Data is the payload of the frame. The unsynchronisation and the compression are already decoded.
+	Data []byte
+}
+
+// ID3Frames returns all the frames in the ID3v2 tag at the beginning of the stream in the order in the tag, e.g. for
+// custom frames like UFID, PRIV and TXXX that Tags doesn't cover. Encrypted frames are omitted.
+//
+// ID3Frames returns nil if there is no tag.
+func (d *Decoder) ID3Frames() []ID3Frame {
+	if d.source.id3v2 == nil {
+		return nil
+	}
+	tag, err := id3.Parse(d.source.id3v2)
+	if err != nil {
+		return nil
+	}
+	frames := make([]ID3Frame, 0, len(tag.Frames))
+	for _, f := range tag.Frames {
+		frames = append(frames, ID3Frame{
+			ID:   id3.CanonicalID(f.ID, tag.MajorVersion),
+			Data: f.Data,
+		})
+	}
+	return frames
+}
+
+// Text decodes the payload of a text frame like TIT2. Multiple values are joined with "/".
+// Text returns an empty string if the frame is not a text frame.
+func (f *ID3Frame) Text() string {
+	if !strings.HasPrefix(f.ID, "T") || f.ID == "TXXX" {
+		return ""
+	}
+	return id3.Text(f.Data)
+}
+
+// UserText decodes the payload of a TXXX frame, e.g. a MusicBrainz ID with the description
+// "MusicBrainz Album Id". ok is false if the frame is not a TXXX frame.
+func (f *ID3Frame) UserText() (description, value string, ok bool) {
+	if f.ID != "TXXX" {
+		return "", "", false
+	}
+	t, err := id3.ParseUserText(f.Data)
+	if err != nil {
+		return "", "", false
+	}
+	return t.Description, t.Value, true
+}
+
+// Owner splits the payload of a frame starting with an owner identifier like UFID and PRIV.
+// For example, the owner of a UFID frame of MusicBrainz is "http://musicbrainz.org" and data is the recording ID.
+// ok is false if the frame is not such a frame.
+func (f *ID3Frame) Owner() (owner string, data []byte, ok bool) {
+	if f.ID != "UFID" && f.ID != "PRIV" {
+		return "", nil, false
+	}
+	i := bytes.IndexByte(f.Data, 0)
+	if i < 0 {
+		return "", nil, false
+	}
+	return string(f.Data[:i]), f.Data[i+1:], true
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"sort"
+)
+
+// frameOffsetsStride is the number of frames per anchor in frameOffsets.
+const frameOffsetsStride = 64
+
+// frameOffsets is the byte offsets of the frames in a stream.
+//
+// As a long stream has millions of frames, the offsets are not stored as int64 values. Instead, the offset of every
+// frameOffsetsStride-th frame is stored as an anchor, and the other frames are stored as the distances from the
+// previous frames, which fit in uint16 unless there are garbage bytes between the frames.
+type frameOffsets struct {
+	anchors []int64
+
+	// deltas is the distance of each frame from the previous frame. 0 means that the distance doesn't fit in
+	// uint16 and the offset is in large.
+	deltas []uint16
+	large  map[int]int64
+
+	last int64
+}
+
+// append appends the offset of the next frame. offset must be greater than the offset of the last frame.
+func (f *frameOffsets) append(offset int64) {
+	i := len(f.deltas)
+	if i%frameOffsetsStride == 0 {
+		f.anchors = append(f.anchors, offset)
+		f.deltas = append(f.deltas, 0)
+	} else if delta := offset - f.last; delta > 0 && delta <= 0xffff {
+		f.deltas = append(f.deltas, uint16(delta))
+	} else {
+		if f.large == nil {
+			f.large = map[int]int64{}
+		}
+		f.large[i] = offset
+		f.deltas = append(f.deltas, 0)
+	}
+	f.last = offset
+}
+
+// len returns the number of the frames. len returns 0 for nil.
+func (f *frameOffsets) len() int {
+	if f == nil {
+		return 0
+	}
+	return len(f.deltas)
+}
+
+// at returns the offset of the i-th frame.
+func (f *frameOffsets) at(i int) int64 {
+	a := i / frameOffsetsStride
+	offset := f.anchors[a]
+	for j := a*frameOffsetsStride + 1; j <= i; j++ {
+		if d := f.deltas[j]; d != 0 {
+			offset += int64(d)
+		} else {
+			offset = f.large[j]
+		}
+	}
+	return offset
+}
+
+// find returns the index of the frame at offset, or -1 if no frame starts at offset.
+func (f *frameOffsets) find(offset int64) int {
+	// The last anchor at or before offset
+	a := sort.Search(len(f.anchors), func(i int) bool {
+		return f.anchors[i] > offset
+	}) - 1
+	if a < 0 {
+		return -1
+	}
+	// offset is before the next anchor, so the frame is in the block of the anchor if any.
+	end := (a + 1) * frameOffsetsStride
+	if end > len(f.deltas) {
+		end = len(f.deltas)
+	}
+	o := f.anchors[a]
+	for i := a * frameOffsetsStride; i < end; i++ {
+		if i > a*frameOffsetsStride {
+			if d := f.deltas[i]; d != 0 {
+				o += int64(d)
+			} else {
+				o = f.large[i]
+			}
+		}
+		if o == offset {
+			return i
+		}
+		if o > offset {
+			break
+		}
+	}
+	return -1
+}
+
+// frameSizes is the numbers of the decoded bytes of the frames in a stream.
+//
+// The frames are usually decoded to the same size, but the size changes when MPEG-1 and MPEG-2 segments are
+// concatenated, which ParameterChangeContinue allows. frameSizes has a run for each change.
+type frameSizes []frameSizeRun
+
+// frameSizeRun is consecutive frames decoded to the same size.
+type frameSizeRun struct {
+	// frame is the index of the first frame of the run, and pos is its position in the decoded stream.
+	frame int64
+	pos   int64
+
+	// size is the number of the decoded bytes of each frame.
+	size int64
+}
+
+// uniform returns the size of the frames and true when all the frames are decoded to the same size.
+func (s frameSizes) uniform() (int64, bool) {
+	if len(s) != 1 {
+		return 0, false
+	}
+	return s[0].size, true
+}
+
+// frameAt returns the index of the frame containing pos in the decoded stream, and the offset of pos in the frame.
+// The last run is considered to continue beyond the end of the stream.
+func (s frameSizes) frameAt(pos int64) (int64, int64) {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].pos > pos
+	}) - 1
+	if i < 0 {
+		return 0, 0
+	}
+	r := s[i]
+	return r.frame + (pos-r.pos)/r.size, (pos - r.pos) % r.size
+}
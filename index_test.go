@@ -0,0 +1,89 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFrameOffsets(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var want []int64
+	f := &frameOffsets{}
+	offset := int64(45)
+	for i := 0; i < 1000; i++ {
+		want = append(want, offset)
+		f.append(offset)
+		if r.Intn(50) == 0 {
+			// Garbage bytes between the frames
+			offset += 0x10000 + r.Int63n(0x10000)
+		} else {
+			offset += 100 + r.Int63n(1400)
+		}
+	}
+
+	if got, want := f.len(), len(want); got != want {
+		t.Errorf("len: got: %d, want: %d", got, want)
+	}
+	for i, o := range want {
+		if got := f.at(i); got != o {
+			t.Errorf("at(%d): got: %d, want: %d", i, got, o)
+		}
+		if got := f.find(o); got != i {
+			t.Errorf("find(%d): got: %d, want: %d", o, got, i)
+		}
+		if got := f.find(o + 1); got != -1 {
+			t.Errorf("find(%d): got: %d, want: -1", o+1, got)
+		}
+	}
+	if got := f.find(0); got != -1 {
+		t.Errorf("find(0): got: %d, want: -1", got)
+	}
+	if got := (*frameOffsets)(nil).len(); got != 0 {
+		t.Errorf("len of nil: got: %d, want: 0", got)
+	}
+}
+
+func TestFrameSizesFrameAt(t *testing.T) {
+	// 10 MPEG-2 frames followed by MPEG-1 frames
+	s := frameSizes{
+		{frame: 0, pos: 0, size: 2304},
+		{frame: 10, pos: 23040, size: 4608},
+	}
+	for _, c := range []struct {
+		pos    int64
+		frame  int64
+		offset int64
+	}{
+		{0, 0, 0},
+		{2303, 0, 2303},
+		{2304, 1, 0},
+		{23039, 9, 2303},
+		{23040, 10, 0},
+		{23040 + 4608*3 + 5, 13, 5},
+	} {
+		frame, offset := s.frameAt(c.pos)
+		if frame != c.frame || offset != c.offset {
+			t.Errorf("frameAt(%d): got: %d, %d, want: %d, %d", c.pos, frame, offset, c.frame, c.offset)
+		}
+	}
+	if _, ok := s.uniform(); ok {
+		t.Errorf("uniform: got: true, want: false")
+	}
+	if size, ok := s[:1].uniform(); !ok || size != 2304 {
+		t.Errorf("uniform: got: %d, %t, want: 2304, true", size, ok)
+	}
+}
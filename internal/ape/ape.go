@@ -0,0 +1,100 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ape parses APEv1 and APEv2 tags.
+package ape
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const (
+	// headerSize is the size of a header and a footer.
+	headerSize = 32
+
+	// itemTypeMask is the mask of the item flags for the item type.
+	itemTypeMask = 0x6
+)
+
+// ItemType is the type of an item value.
+type ItemType int
+
+const (
+	// ItemTypeText is UTF-8 text.
+	ItemTypeText ItemType = 0
+
+	// ItemTypeBinary is binary data like a cover image.
+	ItemTypeBinary ItemType = 1
+
+	// ItemTypeExternal is a locator of external information like a URL.
+	ItemTypeExternal ItemType = 2
+)
+
+// Item is an APE tag item.
+type Item struct {
+	// Key is the key like "Title". Keys are case-insensitive.
+	Key   string
+	Type  ItemType
+	Value []byte
+}
+
+// Text returns the value of a text item. Multiple values separated by zero bytes are joined with "/".
+func (i *Item) Text() string {
+	vs := strings.Split(strings.TrimRight(string(i.Value), "\x00"), "\x00")
+	return strings.Join(vs, "/")
+}
+
+// Parse parses an APE tag. data is the tag including its header if any and its footer.
+func Parse(data []byte) ([]Item, error) {
+	if len(data) < headerSize {
+		return nil, errors.New("ape: tag is too short")
+	}
+	footer := data[len(data)-headerSize:]
+	if string(footer[:8]) != "APETAGEX" {
+		return nil, errors.New("ape: footer is not found")
+	}
+	size := int(binary.LittleEndian.Uint32(footer[12:16]))
+	count := int(binary.LittleEndian.Uint32(footer[16:20]))
+	if size < headerSize || size > len(data) {
+		return nil, errors.New("ape: invalid tag size")
+	}
+	// The size includes the footer and excludes the header.
+	body := data[len(data)-size : len(data)-headerSize]
+
+	var items []Item
+	for i := 0; i < count && len(body) >= 8; i++ {
+		n := int(binary.LittleEndian.Uint32(body[0:4]))
+		flags := binary.LittleEndian.Uint32(body[4:8])
+		body = body[8:]
+		k := bytes.IndexByte(body, 0)
+		if k < 0 {
+			return items, errors.New("ape: item key is not terminated")
+		}
+		key := string(body[:k])
+		body = body[k+1:]
+		if n < 0 || n > len(body) {
+			return items, errors.New("ape: invalid item size")
+		}
+		items = append(items, Item{
+			Key:   key,
+			Type:  ItemType((flags & itemTypeMask) >> 1),
+			Value: body[:n],
+		})
+		body = body[n:]
+	}
+	return items, nil
+}
@@ -14,65 +14,140 @@
 
 package bits
 
+import (
+	"encoding/binary"
+)
+
+// Bits reads bits from a byte slice in the big-endian order.
+//
+// Bits keeps the next bits in a 64-bit cache so that most reads don't touch the byte slice.
+// The bits beyond the byte slice are read as 0.
 type Bits struct {
-	vec     []byte
-	bitPos  int
-	bytePos int
+	// vec is a ring buffer, and the bytes to read are the n bytes from vec[start:], wrapping around at the end.
+	vec   []byte
+	start int
+	n     int
+
+	pos int
+
+	// cache is the bits from pos aligned to the most significant bit. cacheLen is the number of the valid bits in
+	// cache. pos+cacheLen is always a multiple of 8 unless the cache reaches the end of vec.
+	cache    uint64
+	cacheLen int
+
+	// overrun reports whether bits beyond vec have been read.
+	overrun bool
 }
 
 func New(vec []byte) *Bits {
-	return &Bits{
-		vec: vec,
+	b := &Bits{}
+	b.Reset(vec, 0, len(vec))
+	return b
+}
+
+// Reset makes b read the n bytes from ring[start:] from the beginning. The bytes wrap around at the end of ring.
+func (b *Bits) Reset(ring []byte, start, n int) {
+	*b = Bits{
+		vec:   ring,
+		start: start,
+		n:     n,
 	}
+	b.refill()
 }
 
-func Append(bits *Bits, buf []byte) *Bits {
-	return New(append(bits.vec, buf...))
+// refill fills the cache so that the cache has at least 57 bits.
+func (b *Bits) refill() {
+	i := (b.pos + b.cacheLen) >> 3
+	j := b.start + i
+	if j >= len(b.vec) {
+		j -= len(b.vec)
+	}
+	if i+8 <= b.n && j+8 <= len(b.vec) {
+		// The bits beyond cacheLen are also valid, and they are filled again at the same position next time.
+		b.cache |= binary.BigEndian.Uint64(b.vec[j:]) >> uint(b.cacheLen)
+		b.cacheLen += (64 - b.cacheLen) &^ 7
+		return
+	}
+	for b.cacheLen <= 56 {
+		if i >= b.n {
+			// The rest of the cache is zero, which is the bits beyond vec.
+			b.cacheLen = 64
+			return
+		}
+		b.cache |= uint64(b.vec[j]) << uint(56-b.cacheLen)
+		b.cacheLen += 8
+		i++
+		j++
+		if j == len(b.vec) {
+			j = 0
+		}
+	}
 }
 
-func (b *Bits) Bit() int {
-	if len(b.vec) <= b.bytePos {
-		// TODO: Should this return error?
-		return 0
+// skip advances the position by num bits in the cache.
+func (b *Bits) skip(num int) {
+	b.cache <<= uint(num)
+	b.cacheLen -= num
+	b.pos += num
+	if b.pos > b.n<<3 {
+		b.overrun = true
+	}
+	if b.cacheLen <= 56 {
+		b.refill()
 	}
-	tmp := uint(b.vec[b.bytePos]) >> (7 - uint(b.bitPos))
-	tmp &= 0x01
-	b.bytePos += (b.bitPos + 1) >> 3
-	b.bitPos = (b.bitPos + 1) & 0x07
-	return int(tmp)
 }
 
+func (b *Bits) Bit() int {
+	v := int(b.cache >> 63)
+	b.skip(1)
+	return v
+}
+
+// Bits reads num bits. num must be 57 or less.
 func (b *Bits) Bits(num int) int {
 	if num == 0 {
 		return 0
 	}
-	if len(b.vec) <= b.bytePos {
-		// TODO: Should this return error?
-		return 0
-	}
-	bb := make([]byte, 4)
-	copy(bb, b.vec[b.bytePos:])
-	tmp := (uint32(bb[0]) << 24) | (uint32(bb[1]) << 16) | (uint32(bb[2]) << 8) | (uint32(bb[3]))
-	tmp <<= uint(b.bitPos)
-	tmp >>= (32 - uint(num))
-	b.bytePos += (b.bitPos + num) >> 3
-	b.bitPos = (b.bitPos + num) & 0x07
-	return int(tmp)
+	v := int(b.cache >> uint(64-num))
+	b.skip(num)
+	return v
+}
+
+// Peek returns the next num bits without advancing the position. num must be 57 or less.
+// The bits beyond the buffer are read as 0, and Peek doesn't overrun.
+func (b *Bits) Peek(num int) int {
+	return int(b.cache >> uint(64-num))
+}
+
+// Skip advances the position by num bits. num must be 57 or less.
+func (b *Bits) Skip(num int) {
+	b.skip(num)
+}
+
+// Overrun reports whether bits beyond the buffer have been read.
+// The bits beyond the buffer are read as 0.
+func (b *Bits) Overrun() bool {
+	return b.overrun
 }
 
 func (b *Bits) BitPos() int {
-	return b.bytePos<<3 + b.bitPos
+	return b.pos
 }
 
 func (b *Bits) SetPos(pos int) {
-	b.bytePos = pos >> 3
-	b.bitPos = pos & 0x7
+	b.pos = pos &^ 7
+	b.cache = 0
+	b.cacheLen = 0
+	b.refill()
+	// Moving the position doesn't overrun.
+	b.cache <<= uint(pos & 7)
+	b.cacheLen -= pos & 7
+	b.pos = pos
+	if b.cacheLen <= 56 {
+		b.refill()
+	}
 }
 
 func (b *Bits) LenInBytes() int {
-	return len(b.vec)
-}
-
-func (b *Bits) Tail(offset int) []byte {
-	return b.vec[len(b.vec)-offset:]
+	return b.n
 }
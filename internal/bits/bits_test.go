@@ -15,6 +15,7 @@
 package bits_test
 
 import (
+	"math/rand"
 	"testing"
 
 	. "github.com/hajimehoshi/go-mp3/internal/bits"
@@ -45,3 +46,131 @@ func TestBits(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestOverrun(t *testing.T) {
+	b := New([]byte{0xff, 0xff})
+	if b.Bits(12) != 0xfff || b.Overrun() {
+		t.Fatalf("reading within the buffer must not overrun")
+	}
+	// The 4 bits beyond the buffer are read as 0.
+	if got := b.Bits(8); got != 0xf0 {
+		t.Errorf("got: 0x%x, want: 0xf0", got)
+	}
+	if !b.Overrun() {
+		t.Errorf("reading beyond the buffer must overrun")
+	}
+
+	b = New([]byte{0xff})
+	b.Bits(8)
+	if b.Bit() != 0 || !b.Overrun() {
+		t.Errorf("Bit beyond the buffer must overrun")
+	}
+}
+
+func TestPeek(t *testing.T) {
+	b := New([]byte{0x5a, 0xf0})
+	b.Bits(4)
+	if got := b.Peek(8); got != 0xaf {
+		t.Errorf("got: 0x%x, want: 0xaf", got)
+	}
+	if got := b.Bits(8); got != 0xaf {
+		t.Errorf("Peek must not advance the position: got: 0x%x, want: 0xaf", got)
+	}
+	// The bits beyond the buffer are read as 0.
+	if got := b.Peek(8); got != 0 || b.Overrun() {
+		t.Errorf("got: 0x%x, %v, want: 0x0, false", got, b.Overrun())
+	}
+	b.Skip(4)
+	if b.Overrun() {
+		t.Errorf("skipping within the buffer must not overrun")
+	}
+	b.Skip(1)
+	if !b.Overrun() {
+		t.Errorf("skipping beyond the buffer must overrun")
+	}
+}
+
+func TestRandomReads(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vec := make([]byte, 100)
+	r.Read(vec)
+
+	// bit returns the bit at pos in vec, or 0 beyond vec.
+	bit := func(pos int) int {
+		if pos >= len(vec)*8 {
+			return 0
+		}
+		return int(vec[pos/8]>>(7-pos%8)) & 1
+	}
+
+	b := New(vec)
+	pos := 0
+	for i := 0; i < 10000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			if got, want := b.Bit(), bit(pos); got != want {
+				t.Fatalf("Bit at %d: got: %d, want: %d", pos, got, want)
+			}
+			pos++
+		case 1:
+			n := r.Intn(33)
+			want := 0
+			for j := 0; j < n; j++ {
+				want = want<<1 | bit(pos+j)
+			}
+			if got := b.Bits(n); got != want {
+				t.Fatalf("Bits(%d) at %d: got: 0x%x, want: 0x%x", n, pos, got, want)
+			}
+			pos += n
+		case 2:
+			n := r.Intn(25)
+			want := 0
+			for j := 0; j < n; j++ {
+				want = want<<1 | bit(pos+j)
+			}
+			if got := b.Peek(n); got != want {
+				t.Fatalf("Peek(%d) at %d: got: 0x%x, want: 0x%x", n, pos, got, want)
+			}
+		case 3:
+			pos = r.Intn(len(vec)*8 + 64)
+			b.SetPos(pos)
+		}
+		if got := b.BitPos(); got != pos {
+			t.Fatalf("BitPos: got: %d, want: %d", got, pos)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ring := make([]byte, 64)
+	r.Read(ring)
+
+	var b Bits
+	for _, c := range []struct {
+		start int
+		n     int
+	}{
+		{0, 64},
+		{60, 10},
+		{30, 40},
+		{63, 64},
+	} {
+		want := make([]byte, c.n)
+		for i := range want {
+			want[i] = ring[(c.start+i)%len(ring)]
+		}
+		b.Reset(ring, c.start, c.n)
+		for i, w := range want {
+			if got := b.Bits(8); got != int(w) {
+				t.Fatalf("start %d, n %d: byte %d: got: 0x%02x, want: 0x%02x", c.start, c.n, i, got, w)
+			}
+		}
+		if b.Overrun() {
+			t.Errorf("start %d, n %d: reading the bytes must not overrun", c.start, c.n)
+		}
+		if got := b.Bits(8); got != 0 || !b.Overrun() {
+			t.Errorf("start %d, n %d: reading beyond the bytes: got: 0x%02x, overrun: %t", c.start, c.n, got, b.Overrun())
+		}
+	}
+}
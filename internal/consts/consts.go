@@ -15,9 +15,25 @@
 package consts
 
 import (
+	"errors"
 	"fmt"
 )
 
+// The errors classifying decode failures. The mp3 package exports them.
+var (
+	ErrUnsupportedLayer   = errors.New("mp3: unsupported layer")
+	ErrUnsupportedVersion = errors.New("mp3: unsupported MPEG version")
+	ErrFreeBitrate        = errors.New("mp3: free bitrate format is not supported")
+	ErrInvalidHeader      = errors.New("mp3: invalid frame header")
+	ErrInvalidSideInfo    = errors.New("mp3: invalid side information")
+	ErrInvalidMainData    = errors.New("mp3: invalid main data")
+	ErrTruncated          = errors.New("mp3: truncated stream")
+	ErrNoSync             = errors.New("mp3: frame sync is not found")
+	ErrResync             = errors.New("mp3: bytes are skipped to find frame sync")
+	ErrReservoirUnderflow = errors.New("mp3: bit reservoir is insufficient")
+	ErrMemoryLimit        = errors.New("mp3: memory limit exceeded")
+)
+
 type UnexpectedEOF struct {
 	At string
 }
@@ -26,6 +42,11 @@ func (u *UnexpectedEOF) Error() string {
 	return fmt.Sprintf("mp3: unexpected EOF at %s", u.At)
 }
 
+// Unwrap returns ErrTruncated.
+func (u *UnexpectedEOF) Unwrap() error {
+	return ErrTruncated
+}
+
 type Version int
 
 const (
@@ -69,33 +90,49 @@ const (
 	SfBandIndicesShort = 1
 )
 
-var SfBandIndices = [2][3][2][]int{
+// SfBandIndices is the table of scale factor band boundaries indexed by [MPEG-1, MPEG-2, MPEG-2.5][sampling
+// frequency index][long or short].
+var SfBandIndices = [3][3][2][]int{
 	{ // MPEG 1
-		{ // Layer 3
+		{ // 44100 Hz
 			{0, 4, 8, 12, 16, 20, 24, 30, 36, 44, 52, 62, 74, 90, 110, 134, 162, 196, 238, 288, 342, 418, 576},
 			{0, 4, 8, 12, 16, 22, 30, 40, 52, 66, 84, 106, 136, 192},
 		},
-		{ // Layer 2
+		{ // 48000 Hz
 			{0, 4, 8, 12, 16, 20, 24, 30, 36, 42, 50, 60, 72, 88, 106, 128, 156, 190, 230, 276, 330, 384, 576},
 			{0, 4, 8, 12, 16, 22, 28, 38, 50, 64, 80, 100, 126, 192},
 		},
-		{ // Layer 1
+		{ // 32000 Hz
 			{0, 4, 8, 12, 16, 20, 24, 30, 36, 44, 54, 66, 82, 102, 126, 156, 194, 240, 296, 364, 448, 550, 576},
 			{0, 4, 8, 12, 16, 22, 30, 42, 58, 78, 104, 138, 180, 192},
 		},
 	},
 	{ // MPEG 2
-		{ // Layer 3
+		{ // 22050 Hz
 			{0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
 			{0, 4, 8, 12, 18, 24, 32, 42, 56, 74, 100, 132, 174, 192},
 		},
-		{ // Layer 2
+		{ // 24000 Hz
 			{0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 114, 136, 162, 194, 232, 278, 332, 394, 464, 540, 576},
 			{0, 4, 8, 12, 18, 26, 36, 48, 62, 80, 104, 136, 180, 192},
 		},
-		{ // Layer 1
+		{ // 16000 Hz
+			{0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
+			{0, 4, 8, 12, 18, 26, 36, 48, 62, 80, 104, 134, 174, 192},
+		},
+	},
+	{ // MPEG 2.5
+		{ // 11025 Hz
+			{0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
+			{0, 4, 8, 12, 18, 26, 36, 48, 62, 80, 104, 134, 174, 192},
+		},
+		{ // 12000 Hz
 			{0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
 			{0, 4, 8, 12, 18, 26, 36, 48, 62, 80, 104, 134, 174, 192},
 		},
+		{ // 8000 Hz
+			{0, 12, 24, 36, 48, 60, 72, 88, 108, 132, 160, 192, 232, 280, 336, 400, 476, 566, 568, 570, 572, 574, 576},
+			{0, 8, 16, 24, 36, 52, 72, 96, 124, 160, 162, 164, 166, 192},
+		},
 	},
 }
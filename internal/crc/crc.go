@@ -0,0 +1,57 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crc calculates CRC-16 checksums of MPEG audio frames.
+package crc
+
+// Init is the initial value of a CRC-16 checksum.
+const Init = 0xffff
+
+// polynomial is the generator polynomial x^16 + x^15 + x^2 + 1.
+const polynomial = 0x8005
+
+// Update returns the result of adding the bytes in data to crc.
+func Update(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ polynomial
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// reflectedPolynomial is the bit-reversed polynomial for UpdateLAME.
+const reflectedPolynomial = 0xa001
+
+// UpdateLAME returns the result of adding the bytes in data to crc with the CRC-16 used in LAME tags.
+//
+// Unlike Update, the bits are processed from the least significant bit, and the initial value is 0.
+func UpdateLAME(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ reflectedPolynomial
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crc_test
+
+import (
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/internal/crc"
+)
+
+func TestUpdate(t *testing.T) {
+	// The check value of CRC-16/CMS.
+	if got, want := Update(Init, []byte("123456789")), uint16(0xaee7); got != want {
+		t.Errorf("Update: got: 0x%04x, want: 0x%04x", got, want)
+	}
+	// Updating incrementally must give the same result.
+	if got, want := Update(Update(Init, []byte("1234")), []byte("56789")), uint16(0xaee7); got != want {
+		t.Errorf("Update: got: 0x%04x, want: 0x%04x", got, want)
+	}
+}
+
+func TestUpdateLAME(t *testing.T) {
+	// The check value of CRC-16/ARC.
+	if got, want := UpdateLAME(0, []byte("123456789")), uint16(0xbb3d); got != want {
+		t.Errorf("UpdateLAME: got: 0x%04x, want: 0x%04x", got, want)
+	}
+}
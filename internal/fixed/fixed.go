@@ -0,0 +1,56 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fixed provides the fixed-point arithmetic used by the mp3fixed build.
+//
+// The values are Q28 numbers: int32 values with 28 fractional bits, which cover [-8, 8).
+package fixed
+
+import (
+	"math"
+)
+
+const (
+	// FracBits is the number of the fractional bits.
+	FracBits = 28
+
+	// One is 1 in Q28.
+	One = 1 << FracBits
+)
+
+// FromFloat converts x to Q28, rounding to nearest and saturating.
+func FromFloat(x float64) int32 {
+	return Saturate(int64(math.Round(x * One)))
+}
+
+// ToFloat converts v to a floating-point number.
+func ToFloat(v int32) float64 {
+	return float64(v) / One
+}
+
+// Saturate clamps v to [-MaxInt32, MaxInt32]. The range is symmetric so that the result can always be negated.
+func Saturate(v int64) int32 {
+	if v > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if v < -math.MaxInt32 {
+		return -math.MaxInt32
+	}
+	return int32(v)
+}
+
+// Mul returns a*b, rounding to nearest and saturating.
+func Mul(a, b int32) int32 {
+	return Saturate((int64(a)*int64(b) + One/2) >> FracBits)
+}
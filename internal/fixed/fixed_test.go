@@ -0,0 +1,52 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixed_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/internal/fixed"
+)
+
+func TestMul(t *testing.T) {
+	testCases := []struct {
+		A    float64
+		B    float64
+		Want int32
+	}{
+		{1, 1, One},
+		{0.5, -0.5, -One / 4},
+		{-1.5, -2, 3 * One},
+		{4, 4, math.MaxInt32},
+		{-4, 4, -math.MaxInt32},
+	}
+	for _, tc := range testCases {
+		if got := Mul(FromFloat(tc.A), FromFloat(tc.B)); got != tc.Want {
+			t.Errorf("Mul(%v, %v): got: %d, want: %d", tc.A, tc.B, got, tc.Want)
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	for _, x := range []float64{0, 1, -1, 0.7071067811865476, -7.99} {
+		if got := ToFloat(FromFloat(x)); math.Abs(got-x) > 1.0/One {
+			t.Errorf("ToFloat(FromFloat(%v)): got: %v", x, got)
+		}
+	}
+	if got := FromFloat(100); got != math.MaxInt32 {
+		t.Errorf("FromFloat(100): got: %d, want: %d", got, int32(math.MaxInt32))
+	}
+}
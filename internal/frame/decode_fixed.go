@@ -0,0 +1,413 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build mp3fixed
+// +build mp3fixed
+
+package frame
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/fixed"
+	"github.com/hajimehoshi/go-mp3/internal/imdct"
+)
+
+// With the mp3fixed build tag, the frames are decoded in fixed-point arithmetic like libmad, for the targets
+// without a fast floating-point unit. The frequency lines and the time samples are Q28 numbers (see the package
+// fixed), and floating-point numbers are used only to make the tables at the initialization.
+
+var (
+	// pow43Mant and pow43Exp are i^(4/3) = pow43Mant[i] * 2^pow43Exp[i], where pow43Mant[i] is a Q28 number in
+	// [1, 2).
+	pow43Mant [8207]int32
+	pow43Exp  [8207]int8
+
+	// quarterPow2Fixed is 2^(i/4) for i in [0, 4).
+	quarterPow2Fixed [4]int32
+)
+
+func init() {
+	for i := 1; i < len(pow43Mant); i++ {
+		m, e := math.Frexp(math.Pow(float64(i), 4.0/3.0))
+		mant := int64(math.Round(m * 2 * fixed.One))
+		e--
+		if mant == 2*fixed.One {
+			mant = fixed.One
+			e++
+		}
+		pow43Mant[i] = int32(mant)
+		pow43Exp[i] = int8(e)
+	}
+	for i := range quarterPow2Fixed {
+		quarterPow2Fixed[i] = fixed.FromFloat(math.Pow(2, float64(i)/4))
+	}
+}
+
+// requantizeValue returns sign(is) * |is|^(4/3) * 2^(q/4) in Q28, saturating.
+func requantizeValue(is float32, q int) int32 {
+	x := int(is)
+	neg := x < 0
+	if neg {
+		x = -x
+	}
+	if x == 0 {
+		return 0
+	}
+	// p is in Q56 and in [1, 4).
+	p := int64(pow43Mant[x]) * int64(quarterPow2Fixed[q&3])
+	shift := fixed.FracBits - int(pow43Exp[x]) - q>>2
+	var v int32
+	switch {
+	case shift >= 63:
+		v = 0
+	case shift <= 0:
+		v = math.MaxInt32
+	default:
+		v = fixed.Saturate((p + 1<<(shift-1)) >> shift)
+	}
+	if neg {
+		v = -v
+	}
+	return v
+}
+
+// synthState is the state and the working buffers of the decoding.
+type synthState struct {
+	// xr is the frequency lines of a granule, and then its time samples after the hybrid synthesis.
+	xr [2][consts.SamplesPerGr]int32
+
+	store [2][32][18]int32
+
+	// v_vec is the V vectors of the synthesis filterbank. See the float implementation.
+	v_vec [2][2048]int32
+	v_off [2]int
+
+	rawout [36]int32
+}
+
+// decodeGranule decodes the granule gr and writes the PCM to out.
+func (f *Frame) decodeGranule(gr int, out []byte) {
+	nch := f.header.NumberOfChannels()
+	for ch := 0; ch < nch; ch++ {
+		f.requantize(gr, ch)
+	}
+	f.stereo(gr)
+	for ch := 0; ch < nch; ch++ {
+		if !f.fast {
+			f.antialias(gr, ch)
+		}
+		f.hybridSynthesis(gr, ch)
+		f.frequencyInversion(gr, ch)
+		f.subbandSynthesis(gr, ch, out)
+	}
+}
+
+// longGain returns the exponent of the gain of the long block band sfb in units of 1/4.
+func (f *Frame) longGain(gr, ch, sfb int) int {
+	sf_mult := 2
+	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
+		sf_mult = 4
+	}
+	pf_x_pt := f.sideInfo.Preflag[gr][ch] * pretab[sfb]
+	return -(sf_mult * (f.mainData.ScalefacL[gr][ch][sfb] + pf_x_pt)) + (f.sideInfo.GlobalGain[gr][ch] - 210)
+}
+
+// shortGain returns the exponent of the gain of the window win of the short block band sfb in units of 1/4.
+func (f *Frame) shortGain(gr, ch, sfb, win int) int {
+	sf_mult := 2
+	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
+		sf_mult = 4
+	}
+	return -(sf_mult * f.mainData.ScalefacS[gr][ch][sfb][win]) +
+		(f.sideInfo.GlobalGain[gr][ch] - 210 - 8*f.sideInfo.SubblockGain[gr][ch][win])
+}
+
+// requantize requantizes the Huffman-decoded values of the granule gr to xr. The short blocks are reordered at
+// the same time.
+func (f *Frame) requantize(gr int, ch int) {
+	xr := &f.xr[ch]
+	for i := range xr {
+		xr[i] = 0
+	}
+	is := &f.mainData.Is[gr][ch]
+	count1 := f.count1(gr, ch)
+	sfBandIndicesLong, sfBandIndicesShort := f.header.SfBandIndices()
+
+	short := f.sideInfo.WinSwitchFlag[gr][ch] == 1 && f.sideInfo.BlockType[gr][ch] == 2
+	nlong := count1
+	if short {
+		nlong = 0
+		if f.sideInfo.MixedBlockFlag[gr][ch] != 0 {
+			// The first 2 subbands use long blocks.
+			nlong = 36
+		}
+	}
+	sfb := 0
+	next_sfb := sfBandIndicesLong[sfb+1]
+	for i := 0; i < nlong; i++ {
+		if i == next_sfb {
+			sfb++
+			next_sfb = sfBandIndicesLong[sfb+1]
+		}
+		xr[i] = requantizeValue(is[i], f.longGain(gr, ch, sfb))
+	}
+	if !short {
+		return
+	}
+
+	sfb = 0
+	if nlong > 0 {
+		sfb = 3
+	}
+	for i := nlong; i < count1; sfb++ {
+		start := 3 * sfBandIndicesShort[sfb]
+		win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+		for win := 0; win < 3; win++ {
+			q := f.shortGain(gr, ch, sfb, win)
+			for j := 0; j < win_len; j++ {
+				xr[start+3*j+win] = requantizeValue(is[i], q)
+				i++
+			}
+		}
+	}
+}
+
+// isRatiosFixed is the ratios of the left and right channels of MPEG-1 intensity stereo for is_pos in [0, 6].
+var isRatiosFixed [7][2]int32
+
+// isScalesLSFFixed is io^n of MPEG-2 and MPEG-2.5 intensity stereo indexed by intensity_scale and n.
+var isScalesLSFFixed [2][65]int32
+
+func init() {
+	for i := 0; i < 6; i++ {
+		t := math.Tan(float64(i) * math.Pi / 12)
+		isRatiosFixed[i][0] = fixed.FromFloat(t / (1 + t))
+		isRatiosFixed[i][1] = fixed.FromFloat(1 / (1 + t))
+	}
+	// tan(6*PI/12) = tan(PI/2) is infinite.
+	isRatiosFixed[6][0] = fixed.One
+	isRatiosFixed[6][1] = 0
+
+	for i, io := range []float64{1 / math.Sqrt(math.Sqrt2), 1 / math.Sqrt2} {
+		for n := range isScalesLSFFixed[i] {
+			isScalesLSFFixed[i][n] = fixed.FromFloat(math.Pow(io, float64(n)))
+		}
+	}
+}
+
+// intensityRatiosLSF returns the ratios of the left and right channels for the intensity stereo position is_pos
+// of MPEG-2 and MPEG-2.5.
+func (f *Frame) intensityRatiosLSF(is_pos int) (int32, int32) {
+	if is_pos == 0 {
+		return fixed.One, fixed.One
+	}
+	// intensity_scale is the lowest bit of scalefac_compress of the right channel.
+	k := isScalesLSFFixed[f.sideInfo.ScalefacCompress[0][1]&1][(is_pos+1)/2]
+	if is_pos%2 == 1 {
+		return k, fixed.One
+	}
+	return fixed.One, k
+}
+
+// stereoProcessIntensityRange applies the ratios l and r to the frequency lines [start, stop).
+func (f *Frame) stereoProcessIntensityRange(start, stop int, l, r int32) {
+	for i := start; i < stop; i++ {
+		v := f.xr[0][i]
+		f.xr[0][i] = fixed.Mul(v, l)
+		f.xr[1][i] = fixed.Mul(v, r)
+	}
+}
+
+func (f *Frame) stereoProcessIntensityLong(gr int, sfb int) {
+	sfBandIndicesLong, _ := f.header.SfBandIndices()
+	start, stop := sfBandIndicesLong[sfb], sfBandIndicesLong[sfb+1]
+	if f.header.LowSamplingFrequency() == 1 {
+		// The band at the illegal position is not intensity stereo coded.
+		if f.mainData.IllegalPosL[sfb] {
+			return
+		}
+		l, r := f.intensityRatiosLSF(f.mainData.ScalefacL[gr][1][sfb])
+		f.stereoProcessIntensityRange(start, stop, l, r)
+		return
+	}
+	// is_pos of 7 means no intensity stereo.
+	if is_pos := f.mainData.ScalefacL[gr][0][sfb]; is_pos < 7 {
+		f.stereoProcessIntensityRange(start, stop, isRatiosFixed[is_pos][0], isRatiosFixed[is_pos][1])
+	}
+}
+
+func (f *Frame) stereoProcessIntensityShort(gr int, sfb int) {
+	_, sfBandIndicesShort := f.header.SfBandIndices()
+	win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+	for win := 0; win < 3; win++ {
+		start := sfBandIndicesShort[sfb]*3 + win_len*win
+		if f.header.LowSamplingFrequency() == 1 {
+			// The window at the illegal position is not intensity stereo coded.
+			if f.mainData.IllegalPosS[sfb][win] {
+				continue
+			}
+			l, r := f.intensityRatiosLSF(f.mainData.ScalefacS[gr][1][sfb][win])
+			f.stereoProcessIntensityRange(start, start+win_len, l, r)
+			continue
+		}
+		// is_pos of 7 means no intensity stereo.
+		if is_pos := f.mainData.ScalefacS[gr][0][sfb][win]; is_pos < 7 {
+			f.stereoProcessIntensityRange(start, start+win_len, isRatiosFixed[is_pos][0], isRatiosFixed[is_pos][1])
+		}
+	}
+}
+
+// invSqrt2Fixed is 1/sqrt(2) in Q28.
+var invSqrt2Fixed = fixed.FromFloat(math.Sqrt2 / 2)
+
+// stereoProcessMS decodes the middle/side stereo of the granule gr.
+func (f *Frame) stereoProcessMS(gr int) {
+	// Determine how many frequency lines to transform
+	i := 1
+	if f.sideInfo.Count1[gr][0] > f.sideInfo.Count1[gr][1] {
+		i = 0
+	}
+	max_pos := int(f.sideInfo.Count1[gr][i])
+	for i := 0; i < max_pos; i++ {
+		m, s := int64(f.xr[0][i]), int64(f.xr[1][i])
+		f.xr[0][i] = fixed.Saturate((m + s) * int64(invSqrt2Fixed) >> fixed.FracBits)
+		f.xr[1][i] = fixed.Saturate((m - s) * int64(invSqrt2Fixed) >> fixed.FracBits)
+	}
+}
+
+// csFixed and caFixed are the coefficients of the antialiasing butterflies.
+var csFixed, caFixed [8]int32
+
+func init() {
+	for i, c := range []float64{-0.6, -0.535, -0.33, -0.185, -0.095, -0.041, -0.0142, -0.0037} {
+		sq := math.Sqrt(1 + c*c)
+		csFixed[i] = fixed.FromFloat(1 / sq)
+		caFixed[i] = fixed.FromFloat(c / sq)
+	}
+}
+
+func (f *Frame) antialias(gr int, ch int) {
+	// No antialiasing is done for short blocks
+	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+		(f.sideInfo.BlockType[gr][ch] == 2) &&
+		(f.sideInfo.MixedBlockFlag[gr][ch]) == 0 {
+		return
+	}
+	// Setup the limit for how many subbands to transform
+	sblim := 32
+	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+		(f.sideInfo.BlockType[gr][ch] == 2) &&
+		(f.sideInfo.MixedBlockFlag[gr][ch] == 1) {
+		sblim = 2
+	}
+	xr := &f.xr[ch]
+	for sb := 1; sb < sblim; sb++ {
+		for i := 0; i < 8; i++ {
+			li := 18*sb - 1 - i
+			ui := 18*sb + i
+			l, u := int64(xr[li]), int64(xr[ui])
+			xr[li] = fixed.Saturate((l*int64(csFixed[i]) - u*int64(caFixed[i])) >> fixed.FracBits)
+			xr[ui] = fixed.Saturate((u*int64(csFixed[i]) + l*int64(caFixed[i])) >> fixed.FracBits)
+		}
+	}
+}
+
+func (f *Frame) hybridSynthesis(gr int, ch int) {
+	xr := &f.xr[ch]
+	nsb := f.subbands()
+	for sb := 0; sb < nsb; sb++ {
+		// Determine blocktype for this subband
+		bt := int(f.sideInfo.BlockType[gr][ch])
+		if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+			(f.sideInfo.MixedBlockFlag[gr][ch] == 1) && (sb < 2) {
+			bt = 0
+		}
+		rawout := f.rawout[:]
+		imdct.WinFixed(rawout, xr[sb*18:sb*18+18], bt)
+		// Overlap-add with the stored vector
+		for i := 0; i < 18; i++ {
+			xr[sb*18+i] = fixed.Saturate(int64(rawout[i]) + int64(f.store[ch][sb][i]))
+			f.store[ch][sb][i] = rawout[i+18]
+		}
+	}
+	// The higher subbands are not decoded in the fast mode.
+	for i := nsb * 18; i < consts.SamplesPerGr; i++ {
+		xr[i] = 0
+	}
+}
+
+func (f *Frame) frequencyInversion(gr int, ch int) {
+	for sb := 1; sb < 32; sb += 2 {
+		for i := 1; i < 18; i += 2 {
+			f.xr[ch][sb*18+i] = -f.xr[ch][sb*18+i]
+		}
+	}
+}
+
+var (
+	synthNWinFixed [64][32]int32
+	synthDtblFixed [512]int32
+)
+
+func init() {
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 32; j++ {
+			synthNWinFixed[i][j] = fixed.FromFloat(math.Cos(float64((16+i)*(2*j+1)) * (math.Pi / 64.0)))
+		}
+	}
+	for i, d := range synthDtbl {
+		synthDtblFixed[i] = fixed.FromFloat(float64(d))
+	}
+}
+
+func (f *Frame) subbandSynthesis(gr int, ch int, out []byte) {
+	nch := f.header.NumberOfChannels()
+	xr := &f.xr[ch]
+	for ss := 0; ss < 18; ss++ { // Loop through 18 samples in 32 subbands
+		f.v_off[ch] = (f.v_off[ch] - 64) & 1023
+		off := f.v_off[ch]
+		v := f.v_vec[ch][off : off+1024]
+		for i := 0; i < 64; i++ {
+			sum := int64(0)
+			for j := 0; j < 32; j++ {
+				sum += int64(synthNWinFixed[i][j]) * int64(xr[j*18+ss]) >> fixed.FracBits
+			}
+			v[i] = fixed.Saturate(sum)
+		}
+		copy(f.v_vec[ch][off+1024:off+1024+64], v[:64])
+		for i := 0; i < 32; i++ {
+			sum := int64(0)
+			for j := 0; j < 16; j++ {
+				// The U vector consists of v[128n:128n+32] and v[128n+96:128n+128] for n in [0, 8).
+				k := 128*(j>>1) + 96*(j&1) + i
+				sum += int64(v[k]) * int64(synthDtblFixed[32*j+i]) >> fixed.FracBits
+			}
+			// sum now contains time sample 32*ss+i. Convert to 16-bit signed int, truncating toward zero like the
+			// float implementation.
+			samp := sum * 32767
+			if samp < 0 {
+				samp += fixed.One - 1
+			}
+			samp >>= fixed.FracBits
+			if samp > 32767 {
+				samp = 32767
+			} else if samp < -32767 {
+				samp = -32767
+			}
+			putSample(out[4*(32*ss+i):], ch, nch, int16(samp))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build mp3fixed
+// +build mp3fixed
+
+package frame
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/fixed"
+	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
+)
+
+func TestRequantizeValue(t *testing.T) {
+	for _, is := range []float32{0, 1, -1, 2, 15, -100, 8206} {
+		for _, q := range []int{-120, -60, -31, -8, -1, 0} {
+			got := fixed.ToFloat(requantizeValue(is, q))
+			want := math.Pow(math.Abs(float64(is)), 4.0/3.0) * math.Pow(2, float64(q)/4)
+			if is < 0 {
+				want = -want
+			}
+			if want >= 8 {
+				want = fixed.ToFloat(math.MaxInt32)
+			} else if want <= -8 {
+				want = -fixed.ToFloat(math.MaxInt32)
+			}
+			if math.Abs(got-want) > 1.0/fixed.One+math.Abs(want)*1e-8 {
+				t.Errorf("requantizeValue(%v, %d): got: %v, want: %v", is, q, got, want)
+			}
+		}
+	}
+}
+
+func TestIntensityRatiosLSFFixed(t *testing.T) {
+	cases := []struct {
+		scale int
+		isPos int
+		l, r  float64
+	}{
+		{0, 0, 1, 1},
+		{0, 1, math.Pow(2, -0.25), 1},
+		{0, 2, 1, math.Pow(2, -0.25)},
+		{1, 6, 1, math.Pow(2, -1.5)},
+		{1, 127, math.Pow(2, -32), 1},
+	}
+	for _, c := range cases {
+		f := &Frame{
+			sideInfo: &sideinfo.SideInfo{},
+		}
+		f.sideInfo.ScalefacCompress[0][1] = 0x100 | c.scale
+		l, r := f.intensityRatiosLSF(c.isPos)
+		if math.Abs(fixed.ToFloat(l)-c.l) > 1e-8 || math.Abs(fixed.ToFloat(r)-c.r) > 1e-8 {
+			t.Errorf("intensityRatiosLSF(%d) with intensity_scale %d: got: (%f, %f), want: (%f, %f)",
+				c.isPos, c.scale, fixed.ToFloat(l), fixed.ToFloat(r), c.l, c.r)
+		}
+	}
+}
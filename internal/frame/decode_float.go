@@ -0,0 +1,465 @@
+// Copyright 2017 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3fixed
+// +build !mp3fixed
+
+package frame
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/imdct"
+)
+
+//go:generate go run gen_tables.go
+
+// quarterPow2 is 2^(i/4) for i in [0, 4).
+var quarterPow2 = [4]float32{1, 1.189207115002721, 1.4142135623730951, 1.681792830507429}
+
+// pow2Quarters returns 2^(q/4).
+//
+// The gains of the requantization are always multiples of 1/4 in the exponent. pow2Quarters computes them exactly
+// with a table instead of math.Pow, whose result might differ slightly among platforms.
+func pow2Quarters(q int) float32 {
+	e := q >> 2
+	if e < -126 || e > 127 {
+		// The result is not a normal float32 number.
+		return float32(math.Ldexp(float64(quarterPow2[q&3]), e))
+	}
+	// Multiplying by a power of two is exact.
+	return quarterPow2[q&3] * math.Float32frombits(uint32(e+127)<<23)
+}
+
+// synthState is the state and the working buffers of the hybrid synthesis and the synthesis filterbank.
+type synthState struct {
+	store [2][32][18]float32
+
+	// v_vec is the V vectors of the synthesis filterbank. The latest 1024 values of a channel are
+	// v_vec[ch][v_off[ch]:v_off[ch]+1024], whose first 64 values are the newest. Each value is written twice at
+	// i and i+1024 so that the latest values are contiguous without shifting them.
+	v_vec [2][2048]float32
+	v_off [2]int
+
+	// rawout, s_vec and samples are the working buffers of the synthesis.
+	rawout  [36]float32
+	s_vec   [32]float32
+	samples [32]float32
+}
+
+// decodeGranule decodes the granule gr and writes the PCM to out.
+func (f *Frame) decodeGranule(gr int, out []byte) {
+	nch := f.header.NumberOfChannels()
+	for ch := 0; ch < nch; ch++ {
+		f.requantize(gr, ch)
+		f.reorder(gr, ch)
+	}
+	f.stereo(gr)
+	for ch := 0; ch < nch; ch++ {
+		if !f.fast {
+			f.antialias(gr, ch)
+		}
+		f.hybridSynthesis(gr, ch)
+		f.frequencyInversion(gr, ch)
+		f.subbandSynthesis(gr, ch, out)
+	}
+}
+
+func (f *Frame) requantizeProcessLong(gr, ch, is_pos, sfb int) {
+	// The exponent is in units of 1/4.
+	sf_mult := 2
+	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
+		sf_mult = 4
+	}
+	pf_x_pt := f.sideInfo.Preflag[gr][ch] * pretab[sfb]
+	idx := -(sf_mult * (f.mainData.ScalefacL[gr][ch][sfb] + pf_x_pt)) +
+		(f.sideInfo.GlobalGain[gr][ch] - 210)
+	tmp1 := pow2Quarters(idx)
+	tmp2 := float32(0)
+	if f.mainData.Is[gr][ch][is_pos] < 0.0 {
+		tmp2 = -powtab34[int(-f.mainData.Is[gr][ch][is_pos])]
+	} else {
+		tmp2 = powtab34[int(f.mainData.Is[gr][ch][is_pos])]
+	}
+	f.mainData.Is[gr][ch][is_pos] = float32(tmp1 * tmp2)
+}
+
+func (f *Frame) requantizeProcessShort(gr, ch, is_pos, sfb, win int) {
+	// The exponent is in units of 1/4.
+	sf_mult := 2
+	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
+		sf_mult = 4
+	}
+	idx := -(sf_mult * f.mainData.ScalefacS[gr][ch][sfb][win]) +
+		(f.sideInfo.GlobalGain[gr][ch] - 210 - 8*f.sideInfo.SubblockGain[gr][ch][win])
+	tmp1 := pow2Quarters(idx)
+	tmp2 := float32(0)
+	if f.mainData.Is[gr][ch][is_pos] < 0 {
+		tmp2 = -powtab34[int(-f.mainData.Is[gr][ch][is_pos])]
+	} else {
+		tmp2 = powtab34[int(f.mainData.Is[gr][ch][is_pos])]
+	}
+	f.mainData.Is[gr][ch][is_pos] = float32(tmp1 * tmp2)
+}
+
+func (f *Frame) requantize(gr int, ch int) {
+	sfBandIndicesLong, sfBandIndicesShort := f.header.SfBandIndices()
+	// Determine type of block to process
+	if f.sideInfo.WinSwitchFlag[gr][ch] == 1 && f.sideInfo.BlockType[gr][ch] == 2 { // Short blocks
+		// Check if the first two subbands
+		// (=2*18 samples = 8 long or 3 short sfb's) uses long blocks
+		if f.sideInfo.MixedBlockFlag[gr][ch] != 0 { // 2 longbl. sb  first
+			// First process the 2 long block subbands at the start
+			sfb := 0
+			next_sfb := sfBandIndicesLong[sfb+1]
+			for i := 0; i < 36; i++ {
+				if i == next_sfb {
+					sfb++
+					next_sfb = sfBandIndicesLong[sfb+1]
+				}
+				f.requantizeProcessLong(gr, ch, i, sfb)
+			}
+			// And next the remaining,non-zero,bands which uses short blocks
+			sfb = 3
+			next_sfb = sfBandIndicesShort[sfb+1] * 3
+			win_len := sfBandIndicesShort[sfb+1] -
+				sfBandIndicesShort[sfb]
+
+			for i := 36; i < f.count1(gr, ch); /* i++ done below! */ {
+				// Check if we're into the next scalefac band
+				if i == next_sfb {
+					sfb++
+					next_sfb = sfBandIndicesShort[sfb+1] * 3
+					win_len = sfBandIndicesShort[sfb+1] -
+						sfBandIndicesShort[sfb]
+				}
+				for win := 0; win < 3; win++ {
+					for j := 0; j < win_len; j++ {
+						f.requantizeProcessShort(gr, ch, i, sfb, win)
+						i++
+					}
+				}
+
+			}
+		} else { // Only short blocks
+			sfb := 0
+			next_sfb := sfBandIndicesShort[sfb+1] * 3
+			win_len := sfBandIndicesShort[sfb+1] -
+				sfBandIndicesShort[sfb]
+			for i := 0; i < f.count1(gr, ch); /* i++ done below! */ {
+				// Check if we're into the next scalefac band
+				if i == next_sfb {
+					sfb++
+					next_sfb = sfBandIndicesShort[sfb+1] * 3
+					win_len = sfBandIndicesShort[sfb+1] -
+						sfBandIndicesShort[sfb]
+				}
+				for win := 0; win < 3; win++ {
+					for j := 0; j < win_len; j++ {
+						f.requantizeProcessShort(gr, ch, i, sfb, win)
+						i++
+					}
+				}
+			}
+		}
+	} else { // Only long blocks
+		sfb := 0
+		next_sfb := sfBandIndicesLong[sfb+1]
+		for i := 0; i < f.count1(gr, ch); i++ {
+			if i == next_sfb {
+				sfb++
+				next_sfb = sfBandIndicesLong[sfb+1]
+			}
+			f.requantizeProcessLong(gr, ch, i, sfb)
+		}
+	}
+}
+
+func (f *Frame) reorder(gr int, ch int) {
+	re := make([]float32, consts.SamplesPerGr)
+
+	_, sfBandIndicesShort := f.header.SfBandIndices()
+
+	// Only reorder short blocks
+	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) && (f.sideInfo.BlockType[gr][ch] == 2) { // Short blocks
+		// Check if the first two subbands
+		// (=2*18 samples = 8 long or 3 short sfb's) uses long blocks
+		sfb := 0
+		// 2 longbl. sb  first
+		if f.sideInfo.MixedBlockFlag[gr][ch] != 0 {
+			sfb = 3
+		}
+		next_sfb := sfBandIndicesShort[sfb+1] * 3
+		win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+		i := 36
+		if sfb == 0 {
+			i = 0
+		}
+		for i < consts.SamplesPerGr {
+			// Check if we're into the next scalefac band
+			if i == next_sfb {
+				// Copy reordered data back to the original vector
+				j := 3 * sfBandIndicesShort[sfb]
+				copy(f.mainData.Is[gr][ch][j:j+3*win_len], re[0:3*win_len])
+				// Check if this band is above the rzero region,if so we're done
+				if i >= f.sideInfo.Count1[gr][ch] {
+					return
+				}
+				sfb++
+				next_sfb = sfBandIndicesShort[sfb+1] * 3
+				win_len = sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+			}
+			for win := 0; win < 3; win++ { // Do the actual reordering
+				for j := 0; j < win_len; j++ {
+					re[j*3+win] = f.mainData.Is[gr][ch][i]
+					i++
+				}
+			}
+		}
+		// Copy reordered data of last band back to original vector
+		j := 3 * sfBandIndicesShort[12]
+		copy(f.mainData.Is[gr][ch][j:j+3*win_len], re[0:3*win_len])
+	}
+}
+
+var (
+	isRatios = []float32{0.000000, 0.267949, 0.577350, 1.000000, 1.732051, 3.732051}
+)
+
+// isScalesLSF are the base ratios of MPEG-2 and MPEG-2.5 intensity stereo indexed by intensity_scale.
+var isScalesLSF = [2]float64{1 / math.Sqrt(math.Sqrt2), 1 / math.Sqrt2}
+
+// intensityRatiosLSF returns the ratios of the left and right channels for the intensity stereo position is_pos
+// of MPEG-2 and MPEG-2.5.
+func (f *Frame) intensityRatiosLSF(is_pos int) (float32, float32) {
+	if is_pos == 0 {
+		return 1, 1
+	}
+	// intensity_scale is the lowest bit of scalefac_compress of the right channel.
+	io := isScalesLSF[f.sideInfo.ScalefacCompress[0][1]&1]
+	k := float32(math.Pow(io, float64((is_pos+1)/2)))
+	if is_pos%2 == 1 {
+		return k, 1
+	}
+	return 1, k
+}
+
+func (f *Frame) stereoProcessIntensityLongLSF(gr int, sfb int) {
+	// The band at the illegal position is not intensity stereo coded.
+	if f.mainData.IllegalPosL[sfb] {
+		return
+	}
+	sfBandIndicesLong, _ := f.header.SfBandIndices()
+	is_ratio_l, is_ratio_r := f.intensityRatiosLSF(f.mainData.ScalefacL[gr][1][sfb])
+	for i := sfBandIndicesLong[sfb]; i < sfBandIndicesLong[sfb+1]; i++ {
+		v := f.mainData.Is[gr][0][i]
+		f.mainData.Is[gr][0][i] = v * is_ratio_l
+		f.mainData.Is[gr][1][i] = v * is_ratio_r
+	}
+}
+
+func (f *Frame) stereoProcessIntensityShortLSF(gr int, sfb int) {
+	_, sfBandIndicesShort := f.header.SfBandIndices()
+	win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+	for win := 0; win < 3; win++ {
+		// The window at the illegal position is not intensity stereo coded.
+		if f.mainData.IllegalPosS[sfb][win] {
+			continue
+		}
+		is_ratio_l, is_ratio_r := f.intensityRatiosLSF(f.mainData.ScalefacS[gr][1][sfb][win])
+		sfb_start := sfBandIndicesShort[sfb]*3 + win_len*win
+		for i := sfb_start; i < sfb_start+win_len; i++ {
+			v := f.mainData.Is[gr][0][i]
+			f.mainData.Is[gr][0][i] = v * is_ratio_l
+			f.mainData.Is[gr][1][i] = v * is_ratio_r
+		}
+	}
+}
+
+func (f *Frame) stereoProcessIntensityLong(gr int, sfb int) {
+	if f.header.LowSamplingFrequency() == 1 {
+		f.stereoProcessIntensityLongLSF(gr, sfb)
+		return
+	}
+	is_ratio_l := float32(0)
+	is_ratio_r := float32(0)
+	// Check that((is_pos[sfb]=scalefac) < 7) => no intensity stereo
+	if is_pos := f.mainData.ScalefacL[gr][0][sfb]; is_pos < 7 {
+		sfBandIndicesLong, _ := f.header.SfBandIndices()
+		sfb_start := sfBandIndicesLong[sfb]
+		sfb_stop := sfBandIndicesLong[sfb+1]
+		if is_pos == 6 { // tan((6*PI)/12 = PI/2) needs special treatment!
+			is_ratio_l = 1.0
+			is_ratio_r = 0.0
+		} else {
+			is_ratio_l = isRatios[is_pos] / (1.0 + isRatios[is_pos])
+			is_ratio_r = 1.0 / (1.0 + isRatios[is_pos])
+		}
+		// Now decode all samples in this scale factor band
+		for i := sfb_start; i < sfb_stop; i++ {
+			f.mainData.Is[gr][0][i] *= is_ratio_l
+			f.mainData.Is[gr][1][i] *= is_ratio_r
+		}
+	}
+}
+
+func (f *Frame) stereoProcessIntensityShort(gr int, sfb int) {
+	if f.header.LowSamplingFrequency() == 1 {
+		f.stereoProcessIntensityShortLSF(gr, sfb)
+		return
+	}
+	is_ratio_l := float32(0)
+	is_ratio_r := float32(0)
+	_, sfBandIndicesShort := f.header.SfBandIndices()
+	// The window length
+	win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
+	// The three windows within the band has different scalefactors
+	for win := 0; win < 3; win++ {
+		// Check that((is_pos[sfb]=scalefac) < 7) => no intensity stereo
+		is_pos := f.mainData.ScalefacS[gr][0][sfb][win]
+		if is_pos < 7 {
+			sfb_start := sfBandIndicesShort[sfb]*3 + win_len*win
+			sfb_stop := sfb_start + win_len
+			if is_pos == 6 { // tan((6*PI)/12 = PI/2) needs special treatment!
+				is_ratio_l = 1.0
+				is_ratio_r = 0.0
+			} else {
+				is_ratio_l = isRatios[is_pos] / (1.0 + isRatios[is_pos])
+				is_ratio_r = 1.0 / (1.0 + isRatios[is_pos])
+			}
+			// Now decode all samples in this scale factor band
+			for i := sfb_start; i < sfb_stop; i++ {
+				// https://github.com/technosaurus/PDMP3/issues/3
+				f.mainData.Is[gr][0][i] *= is_ratio_l
+				f.mainData.Is[gr][1][i] *= is_ratio_r
+			}
+		}
+	}
+}
+
+// stereoProcessMS decodes the middle/side stereo of the granule gr.
+func (f *Frame) stereoProcessMS(gr int) {
+	// Determine how many frequency lines to transform
+	i := 1
+	if f.sideInfo.Count1[gr][0] > f.sideInfo.Count1[gr][1] {
+		i = 0
+	}
+	max_pos := int(f.sideInfo.Count1[gr][i])
+	// Do the actual processing
+	const invSqrt2 = math.Sqrt2 / 2
+	for i := 0; i < max_pos; i++ {
+		left := (f.mainData.Is[gr][0][i] + f.mainData.Is[gr][1][i]) * invSqrt2
+		right := (f.mainData.Is[gr][0][i] - f.mainData.Is[gr][1][i]) * invSqrt2
+		f.mainData.Is[gr][0][i] = left
+		f.mainData.Is[gr][1][i] = right
+	}
+}
+
+var (
+	cs = []float32{0.857493, 0.881742, 0.949629, 0.983315, 0.995518, 0.999161, 0.999899, 0.999993}
+	ca = []float32{-0.514496, -0.471732, -0.313377, -0.181913, -0.094574, -0.040966, -0.014199, -0.003700}
+)
+
+func (f *Frame) antialias(gr int, ch int) {
+	// No antialiasing is done for short blocks
+	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+		(f.sideInfo.BlockType[gr][ch] == 2) &&
+		(f.sideInfo.MixedBlockFlag[gr][ch]) == 0 {
+		return
+	}
+	// Setup the limit for how many subbands to transform
+	sblim := 32
+	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+		(f.sideInfo.BlockType[gr][ch] == 2) &&
+		(f.sideInfo.MixedBlockFlag[gr][ch] == 1) {
+		sblim = 2
+	}
+	// Do the actual antialiasing
+	for sb := 1; sb < sblim; sb++ {
+		for i := 0; i < 8; i++ {
+			li := 18*sb - 1 - i
+			ui := 18*sb + i
+			// The products are rounded explicitly so that they are not fused into multiply-add instructions,
+			// which would make the output differ among platforms.
+			lb := float32(f.mainData.Is[gr][ch][li]*cs[i]) - float32(f.mainData.Is[gr][ch][ui]*ca[i])
+			ub := float32(f.mainData.Is[gr][ch][ui]*cs[i]) + float32(f.mainData.Is[gr][ch][li]*ca[i])
+			f.mainData.Is[gr][ch][li] = lb
+			f.mainData.Is[gr][ch][ui] = ub
+		}
+	}
+}
+
+func (f *Frame) hybridSynthesis(gr int, ch int) {
+	nsb := f.subbands()
+	for sb := 0; sb < nsb; sb++ {
+		// Determine blocktype for this subband
+		bt := int(f.sideInfo.BlockType[gr][ch])
+		if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
+			(f.sideInfo.MixedBlockFlag[gr][ch] == 1) && (sb < 2) {
+			bt = 0
+		}
+		// Do the inverse modified DCT and windowing
+		rawout := f.rawout[:]
+		imdct.Win(rawout, f.mainData.Is[gr][ch][sb*18:sb*18+18], bt)
+		// Overlapp add with stored vector into main_data vector
+		for i := 0; i < 18; i++ {
+			f.mainData.Is[gr][ch][sb*18+i] = rawout[i] + f.store[ch][sb][i]
+			f.store[ch][sb][i] = rawout[i+18]
+		}
+	}
+	// The higher subbands are not decoded in the fast mode.
+	for i := nsb * 18; i < consts.SamplesPerGr; i++ {
+		f.mainData.Is[gr][ch][i] = 0
+	}
+}
+
+func (f *Frame) frequencyInversion(gr int, ch int) {
+	for sb := 1; sb < 32; sb += 2 {
+		for i := 1; i < 18; i += 2 {
+			f.mainData.Is[gr][ch][sb*18+i] = -f.mainData.Is[gr][ch][sb*18+i]
+		}
+	}
+}
+
+func (f *Frame) subbandSynthesis(gr int, ch int, out []byte) {
+	s_vec := &f.s_vec
+	samples := &f.samples
+
+	nch := f.header.NumberOfChannels()
+	// Setup the n_win windowing vector and the v_vec intermediate vector
+	for ss := 0; ss < 18; ss++ { // Loop through 18 samples in 32 subbands
+		f.v_off[ch] = (f.v_off[ch] - 64) & 1023
+		off := f.v_off[ch]
+		v := f.v_vec[ch][off : off+1024]
+		d := &f.mainData.Is[gr][ch]
+		for i := 0; i < 32; i++ { // Copy next 32 time samples to a temp vector
+			s_vec[i] = d[i*18+ss]
+		}
+		synthMatrix(v, s_vec)
+		copy(f.v_vec[ch][off+1024:off+1024+64], v[:64])
+		synthWindow(samples, v)
+		for i := 0; i < 32; i++ { // Calc 32 samples,store in outdata vector
+			sum := samples[i]
+			// sum now contains time sample 32*ss+i. Convert to 16-bit signed int
+			samp := int(sum * 32767)
+			if samp > 32767 {
+				samp = 32767
+			} else if samp < -32767 {
+				samp = -32767
+			}
+			putSample(out[4*(32*ss+i):], ch, nch, int16(samp))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3fixed
+// +build !mp3fixed
+
+package frame
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
+)
+
+func TestIntensityRatiosLSF(t *testing.T) {
+	cases := []struct {
+		scale int
+		isPos int
+		l, r  float64
+	}{
+		{0, 0, 1, 1},
+		{0, 1, math.Pow(2, -0.25), 1},
+		{0, 2, 1, math.Pow(2, -0.25)},
+		{0, 3, math.Pow(2, -0.5), 1},
+		{0, 4, 1, math.Pow(2, -0.5)},
+		{1, 1, math.Pow(2, -0.5), 1},
+		{1, 6, 1, math.Pow(2, -1.5)},
+	}
+	for _, c := range cases {
+		f := &Frame{
+			sideInfo: &sideinfo.SideInfo{},
+		}
+		f.sideInfo.ScalefacCompress[0][1] = 0x100 | c.scale
+		l, r := f.intensityRatiosLSF(c.isPos)
+		if math.Abs(float64(l)-c.l) > 1e-6 || math.Abs(float64(r)-c.r) > 1e-6 {
+			t.Errorf("intensityRatiosLSF(%d) with intensity_scale %d: got: (%f, %f), want: (%f, %f)",
+				c.isPos, c.scale, l, r, c.l, c.r)
+		}
+	}
+}
+
+// TestGeneratedTables checks that the tables generated by gen_tables.go are up to date.
+func TestGeneratedTables(t *testing.T) {
+	for i, got := range powtab34 {
+		if want := float32(math.Pow(float64(i), 4.0/3.0)); got != want {
+			t.Fatalf("powtab34[%d]: got: %v, want: %v", i, got, want)
+		}
+	}
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 32; j++ {
+			want := float32(math.Cos(float64((16+i)*(2*j+1)) * (math.Pi / 64.0)))
+			if got := synthNWin[i][j]; got != want {
+				t.Fatalf("synthNWin[%d][%d]: got: %v, want: %v", i, j, got, want)
+			}
+			if got := synthNWinT[j][i]; got != want {
+				t.Fatalf("synthNWinT[%d][%d]: got: %v, want: %v", j, i, got, want)
+			}
+		}
+	}
+}
@@ -17,26 +17,17 @@ package frame
 import (
 	"fmt"
 	"io"
-	"math"
+	"unsafe"
 
 	"github.com/hajimehoshi/go-mp3/internal/bits"
 	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/crc"
 	"github.com/hajimehoshi/go-mp3/internal/frameheader"
-	"github.com/hajimehoshi/go-mp3/internal/imdct"
 	"github.com/hajimehoshi/go-mp3/internal/maindata"
 	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
 )
 
-var (
-	powtab34 = make([]float64, 8207)
-	pretab   = []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 3, 3, 3, 2, 0}
-)
-
-func init() {
-	for i := range powtab34 {
-		powtab34[i] = math.Pow(float64(i), 4.0/3.0)
-	}
-}
+var pretab = []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 3, 3, 3, 2, 0}
 
 type Frame struct {
 	header   frameheader.FrameHeader
@@ -44,337 +35,250 @@ type Frame struct {
 	mainData *maindata.MainData
 
 	mainDataBits *bits.Bits
-	store        [2][32][18]float32
-	v_vec        [2][1024]float32
+
+	// reservoir is the bit reservoir, which has mainDataBits and is shared by the following frames.
+	reservoir *maindata.Reservoir
+
+	synthState
+
+	// spareMainData and spareSideInfo are the main data and the side information of the previous frame, which are
+	// reused for the next frame.
+	spareMainData *maindata.MainData
+	spareSideInfo *sideinfo.SideInfo
+
+	// buf is the buffer to read the frame header and the CRC into.
+	buf [4]byte
+
+	crcMismatch        bool
+	reservoirUnderflow bool
+
+	// fast reports whether the frame is decoded in the fast mode. See Options.
+	fast bool
 }
 
 type FullReader interface {
 	ReadFull([]byte) (int, error)
 }
 
-func readCRC(source FullReader) error {
-	buf := make([]byte, 2)
+func readCRC(source FullReader, buf []byte) (uint16, error) {
+	buf = buf[:2]
 	if n, err := source.ReadFull(buf); n < 2 {
 		if err == io.EOF {
-			return &consts.UnexpectedEOF{"readCRC"}
+			return 0, &consts.UnexpectedEOF{"readCRC"}
+		}
+		return 0, fmt.Errorf("mp3: error at readCRC: %w", err)
+	}
+	return uint16(buf[0])<<8 | uint16(buf[1]), nil
+}
+
+// Options represents options to read frames.
+type Options struct {
+	// Header is the options to read frame headers.
+	Header frameheader.Options
+
+	// Partial specifies whether a frame whose main data is cut at the end of the source is decoded as far as
+	// available. The missing frequency lines are decoded as zero.
+	Partial bool
+
+	// MaxReservoirBytes is the maximum number of bytes of the previous frames' main data a frame can refer back
+	// to. If MaxReservoirBytes is 0, the number is not limited.
+	MaxReservoirBytes int
+
+	// Fast specifies whether the frames are decoded in the fast mode, which decodes only the lower half of the
+	// subbands and skips the alias reduction.
+	Fast bool
+}
+
+// New returns a new frame with no previous frames, which can be passed to Read as prev.
+//
+// The main data and the side information are allocated for the first two frames in advance, so that reading frames
+// with the returned frame doesn't allocate.
+func New() *Frame {
+	return &Frame{
+		sideInfo:      &sideinfo.SideInfo{},
+		mainData:      &maindata.MainData{},
+		reservoir:     &maindata.Reservoir{},
+		spareMainData: &maindata.MainData{},
+		spareSideInfo: &sideinfo.SideInfo{},
+	}
+}
+
+// Size returns the number of the bytes of the memory f holds.
+func (f *Frame) Size() int {
+	n := int(unsafe.Sizeof(*f))
+	if f.reservoir != nil {
+		n += int(unsafe.Sizeof(*f.reservoir))
+	}
+	for _, md := range []*maindata.MainData{f.mainData, f.spareMainData} {
+		if md != nil {
+			n += int(unsafe.Sizeof(*md))
 		}
-		return fmt.Errorf("mp3: error at readCRC: %v", err)
 	}
-	return nil
+	for _, si := range []*sideinfo.SideInfo{f.sideInfo, f.spareSideInfo} {
+		if si != nil {
+			n += int(unsafe.Sizeof(*si))
+		}
+	}
+	return n
+}
+
+// Reset makes f a frame with no previous frames like New, so that f can be reused for another stream or after seeking
+// without allocating a new frame.
+func (f *Frame) Reset() {
+	// The main data and the side information are kept to be reused by the next frame. The bit reservoir is emptied
+	// by forgetting the previous main data.
+	f.mainDataBits = nil
+	f.synthState = synthState{}
+	f.crcMismatch = false
+	f.reservoirUnderflow = false
+	f.fast = false
 }
 
-func Read(source FullReader, position int64, prev *Frame) (frame *Frame, startPosition int64, err error) {
-	h, pos, err := frameheader.Read(source, position)
+// Read reads a frame from source.
+//
+// startPosition is the position of the frame header. startPosition is valid even when err is not nil if the frame
+// header is read.
+//
+// prev is the previous frame, which is updated in place to be the new frame and returned, so that the steady state
+// doesn't allocate frames. If err is not nil, prev is not changed except for its spare buffers.
+func Read(source FullReader, position int64, prev *Frame, options Options) (frame *Frame, startPosition int64, err error) {
+	nf := prev
+	if nf == nil {
+		nf = New()
+	}
+
+	h, pos, err := frameheader.Read(source, position, options.Header, nf.buf[:])
 	if err != nil {
 		return nil, 0, err
 	}
 
+	var storedCRC uint16
 	if h.ProtectionBit() == 0 {
-		if err := readCRC(source); err != nil {
-			return nil, 0, err
+		c, err := readCRC(source, nf.buf[:])
+		if err != nil {
+			return nil, pos, err
 		}
+		storedCRC = c
 	}
 
-	if h.ID() == consts.Version2_5 {
-		return nil, 0, fmt.Errorf("mp3: MPEG version 2.5 is not supported")
-	}
 	if h.Layer() != consts.Layer3 {
-		return nil, 0, fmt.Errorf("mp3: only layer3 (want %d; got %d) is supported", consts.Layer3, h.Layer())
+		return nil, pos, fmt.Errorf("%w: only layer 3 is supported (got layer %d)", consts.ErrUnsupportedLayer, 4-int(h.Layer()))
 	}
 
-	si, err := sideinfo.Read(source, h)
+	si, err := sideinfo.Read(source, h, nf.spareSideInfo)
 	if err != nil {
-		return nil, 0, err
+		return nil, pos, err
+	}
+
+	if options.MaxReservoirBytes > 0 && si.MainDataBegin > options.MaxReservoirBytes {
+		return nil, pos, fmt.Errorf("%w: main_data_begin = %d exceeds %d bytes", consts.ErrMemoryLimit, si.MainDataBegin, options.MaxReservoirBytes)
+	}
+
+	// The CRC covers the last 16 bits of the header and the side information.
+	crcMismatch := false
+	if h.ProtectionBit() == 0 {
+		nf.buf[0], nf.buf[1] = byte(h>>8), byte(h)
+		c := crc.Update(crc.Init, nf.buf[:2])
+		c = crc.Update(c, si.Raw)
+		crcMismatch = c != storedCRC
 	}
 
 	// If there's not enough main data in the bit reservoir,
 	// signal to calling function so that decoding isn't done!
 	// Get main data (scalefactors and Huffman coded frequency data)
-	var prevM *bits.Bits
-	if prev != nil {
-		prevM = prev.mainDataBits
+	prevM := nf.mainDataBits
+	// The main data refers back to more bytes than the previous frames have.
+	// Such a frame is decoded as silence.
+	underflow := si.MainDataBegin > 0 && (prevM == nil || si.MainDataBegin > prevM.LenInBytes())
+	// The previous frame's spare main data is not in use. Keep prev's own main data intact in case this frame is
+	// broken and prev is used again.
+	maxLines := consts.SamplesPerGr
+	if options.Fast {
+		maxLines = fastSubbands * 18
 	}
-	md, mdb, err := maindata.Read(source, prevM, h, si)
+	md, mdb, err := maindata.Read(source, nf.reservoir, prevM, h, si, options.Partial, maxLines, nf.spareMainData)
 	if err != nil {
-		return nil, 0, err
+		return nil, pos, err
 	}
-	nf := &Frame{
-		header:       h,
-		sideInfo:     si,
-		mainData:     md,
-		mainDataBits: mdb,
-	}
-	if prev != nil {
-		nf.store = prev.store
-		nf.v_vec = prev.v_vec
+	// The scale factors and the Huffman codes must not exceed the main data.
+	// With an insufficient reservoir, the main data is broken anyway. With truncated main data, the main data is
+	// decoded as far as available.
+	if mdb.Overrun() && !underflow && !md.Truncated {
+		return nil, pos, fmt.Errorf("%w: main data overrun", consts.ErrInvalidMainData)
 	}
+	// The synthesis state in store and v_vec carries over. The main data and the side information of prev are
+	// reused for the next frame.
+	nf.spareMainData = nf.mainData
+	nf.spareSideInfo = nf.sideInfo
+	nf.header = h
+	nf.sideInfo = si
+	nf.mainData = md
+	nf.mainDataBits = mdb
+	nf.crcMismatch = crcMismatch
+	nf.reservoirUnderflow = underflow
+	nf.fast = options.Fast
 	return nf, pos, nil
 }
 
-func (f *Frame) SamplingFrequency() (int, error) {
-	return f.header.SamplingFrequencyValue()
+// CRCMismatch reports whether the frame is protected by a CRC and the CRC doesn't match.
+func (f *Frame) CRCMismatch() bool {
+	return f.crcMismatch
 }
 
-func (f *Frame) Decode() []byte {
-	out := make([]byte, f.header.BytesPerFrame())
-	nch := f.header.NumberOfChannels()
-	for gr := 0; gr < f.header.Granules(); gr++ {
-		for ch := 0; ch < nch; ch++ {
-			f.requantize(gr, ch)
-			f.reorder(gr, ch)
-		}
-		f.stereo(gr)
-		for ch := 0; ch < nch; ch++ {
-			f.antialias(gr, ch)
-			f.hybridSynthesis(gr, ch)
-			f.frequencyInversion(gr, ch)
-			f.subbandSynthesis(gr, ch, out[consts.SamplesPerGr*4*gr:])
-		}
-	}
-	return out
+// Truncated reports whether the main data of the frame is cut at the end of the source and decoded partially.
+func (f *Frame) Truncated() bool {
+	return f.mainData.Truncated
 }
 
-func (f *Frame) requantizeProcessLong(gr, ch, is_pos, sfb int) {
-	sf_mult := 0.5
-	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
-		sf_mult = 1.0
-	}
-	pf_x_pt := float64(f.sideInfo.Preflag[gr][ch]) * pretab[sfb]
-	idx := -(sf_mult * (float64(f.mainData.ScalefacL[gr][ch][sfb]) + pf_x_pt)) +
-		0.25*(float64(f.sideInfo.GlobalGain[gr][ch])-210)
-	tmp1 := math.Pow(2.0, idx)
-	tmp2 := 0.0
-	if f.mainData.Is[gr][ch][is_pos] < 0.0 {
-		tmp2 = -powtab34[int(-f.mainData.Is[gr][ch][is_pos])]
-	} else {
-		tmp2 = powtab34[int(f.mainData.Is[gr][ch][is_pos])]
-	}
-	f.mainData.Is[gr][ch][is_pos] = float32(tmp1 * tmp2)
+// ReservoirUnderflow reports whether the main data of the frame refers back to more bytes than the bit reservoir
+// has, e.g. at the beginning of a stream cut in the middle.
+func (f *Frame) ReservoirUnderflow() bool {
+	return f.reservoirUnderflow
 }
 
-func (f *Frame) requantizeProcessShort(gr, ch, is_pos, sfb, win int) {
-	sf_mult := 0.5
-	if f.sideInfo.ScalefacScale[gr][ch] != 0 {
-		sf_mult = 1.0
-	}
-	idx := -(sf_mult * float64(f.mainData.ScalefacS[gr][ch][sfb][win])) +
-		0.25*(float64(f.sideInfo.GlobalGain[gr][ch])-210.0-
-			8.0*float64(f.sideInfo.SubblockGain[gr][ch][win]))
-	tmp1 := math.Pow(2.0, idx)
-	tmp2 := 0.0
-	if f.mainData.Is[gr][ch][is_pos] < 0 {
-		tmp2 = -powtab34[int(-f.mainData.Is[gr][ch][is_pos])]
-	} else {
-		tmp2 = powtab34[int(f.mainData.Is[gr][ch][is_pos])]
-	}
-	f.mainData.Is[gr][ch][is_pos] = float32(tmp1 * tmp2)
+func (f *Frame) Header() frameheader.FrameHeader {
+	return f.header
 }
 
-func getSfBandIndicesArray(header *frameheader.FrameHeader) ([]int, []int) {
-	sfreq := header.SamplingFrequency() // Setup sampling frequency index
-	lsf := header.LowSamplingFrequency()
-	sfBandIndicesShort := consts.SfBandIndices[lsf][sfreq][consts.SfBandIndicesShort]
-	sfBandIndicesLong := consts.SfBandIndices[lsf][sfreq][consts.SfBandIndicesLong]
-	return sfBandIndicesLong, sfBandIndicesShort
+func (f *Frame) SamplingFrequency() (int, error) {
+	return f.header.SamplingFrequencyValue()
 }
 
-func (f *Frame) requantize(gr int, ch int) {
-	sfBandIndicesLong, sfBandIndicesShort := getSfBandIndicesArray(&f.header)
-	// Determine type of block to process
-	if f.sideInfo.WinSwitchFlag[gr][ch] == 1 && f.sideInfo.BlockType[gr][ch] == 2 { // Short blocks
-		// Check if the first two subbands
-		// (=2*18 samples = 8 long or 3 short sfb's) uses long blocks
-		if f.sideInfo.MixedBlockFlag[gr][ch] != 0 { // 2 longbl. sb  first
-			// First process the 2 long block subbands at the start
-			sfb := 0
-			next_sfb := sfBandIndicesLong[sfb+1]
-			for i := 0; i < 36; i++ {
-				if i == next_sfb {
-					sfb++
-					next_sfb = sfBandIndicesLong[sfb+1]
-				}
-				f.requantizeProcessLong(gr, ch, i, sfb)
-			}
-			// And next the remaining,non-zero,bands which uses short blocks
-			sfb = 3
-			next_sfb = sfBandIndicesShort[sfb+1] * 3
-			win_len := sfBandIndicesShort[sfb+1] -
-				sfBandIndicesShort[sfb]
-
-			for i := 36; i < int(f.sideInfo.Count1[gr][ch]); /* i++ done below! */ {
-				// Check if we're into the next scalefac band
-				if i == next_sfb {
-					sfb++
-					next_sfb = sfBandIndicesShort[sfb+1] * 3
-					win_len = sfBandIndicesShort[sfb+1] -
-						sfBandIndicesShort[sfb]
-				}
-				for win := 0; win < 3; win++ {
-					for j := 0; j < win_len; j++ {
-						f.requantizeProcessShort(gr, ch, i, sfb, win)
-						i++
-					}
-				}
-
-			}
-		} else { // Only short blocks
-			sfb := 0
-			next_sfb := sfBandIndicesShort[sfb+1] * 3
-			win_len := sfBandIndicesShort[sfb+1] -
-				sfBandIndicesShort[sfb]
-			for i := 0; i < int(f.sideInfo.Count1[gr][ch]); /* i++ done below! */ {
-				// Check if we're into the next scalefac band
-				if i == next_sfb {
-					sfb++
-					next_sfb = sfBandIndicesShort[sfb+1] * 3
-					win_len = sfBandIndicesShort[sfb+1] -
-						sfBandIndicesShort[sfb]
-				}
-				for win := 0; win < 3; win++ {
-					for j := 0; j < win_len; j++ {
-						f.requantizeProcessShort(gr, ch, i, sfb, win)
-						i++
-					}
-				}
-			}
-		}
-	} else { // Only long blocks
-		sfb := 0
-		next_sfb := sfBandIndicesLong[sfb+1]
-		for i := 0; i < int(f.sideInfo.Count1[gr][ch]); i++ {
-			if i == next_sfb {
-				sfb++
-				next_sfb = sfBandIndicesLong[sfb+1]
-			}
-			f.requantizeProcessLong(gr, ch, i, sfb)
-		}
-	}
+func (f *Frame) FrameSize() (int, error) {
+	return f.header.FrameSize()
 }
 
-func (f *Frame) reorder(gr int, ch int) {
-	re := make([]float32, consts.SamplesPerGr)
-
-	_, sfBandIndicesShort := getSfBandIndicesArray(&f.header)
-
-	// Only reorder short blocks
-	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) && (f.sideInfo.BlockType[gr][ch] == 2) { // Short blocks
-		// Check if the first two subbands
-		// (=2*18 samples = 8 long or 3 short sfb's) uses long blocks
-		sfb := 0
-		// 2 longbl. sb  first
-		if f.sideInfo.MixedBlockFlag[gr][ch] != 0 {
-			sfb = 3
-		}
-		next_sfb := sfBandIndicesShort[sfb+1] * 3
-		win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
-		i := 36
-		if sfb == 0 {
-			i = 0
-		}
-		for i < consts.SamplesPerGr {
-			// Check if we're into the next scalefac band
-			if i == next_sfb {
-				// Copy reordered data back to the original vector
-				j := 3 * sfBandIndicesShort[sfb]
-				copy(f.mainData.Is[gr][ch][j:j+3*win_len], re[0:3*win_len])
-				// Check if this band is above the rzero region,if so we're done
-				if i >= f.sideInfo.Count1[gr][ch] {
-					return
-				}
-				sfb++
-				next_sfb = sfBandIndicesShort[sfb+1] * 3
-				win_len = sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
-			}
-			for win := 0; win < 3; win++ { // Do the actual reordering
-				for j := 0; j < win_len; j++ {
-					re[j*3+win] = f.mainData.Is[gr][ch][i]
-					i++
-				}
-			}
-		}
-		// Copy reordered data of last band back to original vector
-		j := 3 * sfBandIndicesShort[12]
-		copy(f.mainData.Is[gr][ch][j:j+3*win_len], re[0:3*win_len])
-	}
+// Decode decodes the frame and returns the PCM in a newly allocated slice.
+func (f *Frame) Decode() []byte {
+	return f.DecodeTo(nil)
 }
 
-var (
-	isRatios = []float32{0.000000, 0.267949, 0.577350, 1.000000, 1.732051, 3.732051}
-)
-
-func (f *Frame) stereoProcessIntensityLong(gr int, sfb int) {
-	is_ratio_l := float32(0)
-	is_ratio_r := float32(0)
-	// Check that((is_pos[sfb]=scalefac) < 7) => no intensity stereo
-	if is_pos := f.mainData.ScalefacL[gr][0][sfb]; is_pos < 7 {
-		sfBandIndicesLong, _ := getSfBandIndicesArray(&f.header)
-		sfb_start := sfBandIndicesLong[sfb]
-		sfb_stop := sfBandIndicesLong[sfb+1]
-		if is_pos == 6 { // tan((6*PI)/12 = PI/2) needs special treatment!
-			is_ratio_l = 1.0
-			is_ratio_r = 0.0
-		} else {
-			is_ratio_l = isRatios[is_pos] / (1.0 + isRatios[is_pos])
-			is_ratio_r = 1.0 / (1.0 + isRatios[is_pos])
-		}
-		// Now decode all samples in this scale factor band
-		for i := sfb_start; i < sfb_stop; i++ {
-			f.mainData.Is[gr][0][i] *= is_ratio_l
-			f.mainData.Is[gr][1][i] *= is_ratio_r
-		}
+// DecodeTo decodes the frame and returns the PCM. DecodeTo reuses out if out has enough capacity.
+func (f *Frame) DecodeTo(out []byte) []byte {
+	if n := f.header.BytesPerFrame(); cap(out) >= n {
+		out = out[:n]
+	} else {
+		out = make([]byte, n)
 	}
-}
-
-func (f *Frame) stereoProcessIntensityShort(gr int, sfb int) {
-	is_ratio_l := float32(0)
-	is_ratio_r := float32(0)
-	_, sfBandIndicesShort := getSfBandIndicesArray(&f.header)
-	// The window length
-	win_len := sfBandIndicesShort[sfb+1] - sfBandIndicesShort[sfb]
-	// The three windows within the band has different scalefactors
-	for win := 0; win < 3; win++ {
-		// Check that((is_pos[sfb]=scalefac) < 7) => no intensity stereo
-		is_pos := f.mainData.ScalefacS[gr][0][sfb][win]
-		if is_pos < 7 {
-			sfb_start := sfBandIndicesShort[sfb]*3 + win_len*win
-			sfb_stop := sfb_start + win_len
-			if is_pos == 6 { // tan((6*PI)/12 = PI/2) needs special treatment!
-				is_ratio_l = 1.0
-				is_ratio_r = 0.0
-			} else {
-				is_ratio_l = isRatios[is_pos] / (1.0 + isRatios[is_pos])
-				is_ratio_r = 1.0 / (1.0 + isRatios[is_pos])
-			}
-			// Now decode all samples in this scale factor band
-			for i := sfb_start; i < sfb_stop; i++ {
-				// https://github.com/technosaurus/PDMP3/issues/3
-				f.mainData.Is[gr][0][i] *= is_ratio_l
-				f.mainData.Is[gr][1][i] *= is_ratio_r
-			}
-		}
+	for gr := 0; gr < f.header.Granules(); gr++ {
+		f.decodeGranule(gr, out[consts.SamplesPerGr*4*gr:])
 	}
+	return out
 }
 
 func (f *Frame) stereo(gr int) {
 	if f.header.UseMSStereo() {
-		// Determine how many frequency lines to transform
-		i := 1
-		if f.sideInfo.Count1[gr][0] > f.sideInfo.Count1[gr][1] {
-			i = 0
-		}
-		max_pos := int(f.sideInfo.Count1[gr][i])
-		// Do the actual processing
-		const invSqrt2 = math.Sqrt2 / 2
-		for i := 0; i < max_pos; i++ {
-			left := (f.mainData.Is[gr][0][i] + f.mainData.Is[gr][1][i]) * invSqrt2
-			right := (f.mainData.Is[gr][0][i] - f.mainData.Is[gr][1][i]) * invSqrt2
-			f.mainData.Is[gr][0][i] = left
-			f.mainData.Is[gr][1][i] = right
-		}
+		f.stereoProcessMS(gr)
 	}
 
 	if f.header.UseIntensityStereo() {
-		sfBandIndicesLong, sfBandIndicesShort := getSfBandIndicesArray(&f.header)
+		sfBandIndicesLong, sfBandIndicesShort := f.header.SfBandIndices()
+		// The number of long scale factor bands in mixed blocks and the numbers of processed bands.
+		// MPEG-2 and MPEG-2.5 also process the last bands, which have no scale factors.
+		mixedLong, nLong, nShort := 8, 21, 12
+		if f.header.LowSamplingFrequency() == 1 {
+			mixedLong, nLong, nShort = 6, 22, 13
+		}
 		// First band that is intensity stereo encoded is first band scale factor
 		// band on or above count1 frequency line. N.B.: Intensity stereo coding is
 		// only done for higher subbands, but logic is here for lower subbands.
@@ -384,21 +288,21 @@ func (f *Frame) stereo(gr int) {
 			// Check if the first two subbands
 			// (=2*18 samples = 8 long or 3 short sfb's) uses long blocks
 			if f.sideInfo.MixedBlockFlag[gr][0] != 0 { // 2 longbl. sb  first
-				for sfb := 0; sfb < 8; sfb++ { // First process 8 sfb's at start
+				for sfb := 0; sfb < mixedLong; sfb++ { // First process 8 (MPEG-1) or 6 sfb's at start
 					// Is this scale factor band above count1 for the right channel?
 					if sfBandIndicesLong[sfb] >= f.sideInfo.Count1[gr][1] {
 						f.stereoProcessIntensityLong(gr, sfb)
 					}
 				}
 				// And next the remaining bands which uses short blocks
-				for sfb := 3; sfb < 12; sfb++ {
+				for sfb := 3; sfb < nShort; sfb++ {
 					// Is this scale factor band above count1 for the right channel?
 					if sfBandIndicesShort[sfb]*3 >= f.sideInfo.Count1[gr][1] {
 						f.stereoProcessIntensityShort(gr, sfb)
 					}
 				}
 			} else { // Only short blocks
-				for sfb := 0; sfb < 12; sfb++ {
+				for sfb := 0; sfb < nShort; sfb++ {
 					// Is this scale factor band above count1 for the right channel?
 					if sfBandIndicesShort[sfb]*3 >= f.sideInfo.Count1[gr][1] {
 						f.stereoProcessIntensityShort(gr, sfb)
@@ -406,7 +310,7 @@ func (f *Frame) stereo(gr int) {
 				}
 			}
 		} else { // Only long blocks
-			for sfb := 0; sfb < 21; sfb++ {
+			for sfb := 0; sfb < nLong; sfb++ {
 				// Is this scale factor band above count1 for the right channel?
 				if sfBandIndicesLong[sfb] >= f.sideInfo.Count1[gr][1] {
 					f.stereoProcessIntensityLong(gr, sfb)
@@ -416,77 +320,43 @@ func (f *Frame) stereo(gr int) {
 	}
 }
 
-var (
-	cs = []float32{0.857493, 0.881742, 0.949629, 0.983315, 0.995518, 0.999161, 0.999899, 0.999993}
-	ca = []float32{-0.514496, -0.471732, -0.313377, -0.181913, -0.094574, -0.040966, -0.014199, -0.003700}
-)
+// fastSubbands is the number of the subbands decoded in the fast mode, which covers up to a quarter of the sampling
+// frequency.
+const fastSubbands = 16
 
-func (f *Frame) antialias(gr int, ch int) {
-	// No antialiasing is done for short blocks
-	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
-		(f.sideInfo.BlockType[gr][ch] == 2) &&
-		(f.sideInfo.MixedBlockFlag[gr][ch]) == 0 {
-		return
-	}
-	// Setup the limit for how many subbands to transform
-	sblim := 32
-	if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
-		(f.sideInfo.BlockType[gr][ch] == 2) &&
-		(f.sideInfo.MixedBlockFlag[gr][ch] == 1) {
-		sblim = 2
-	}
-	// Do the actual antialiasing
-	for sb := 1; sb < sblim; sb++ {
-		for i := 0; i < 8; i++ {
-			li := 18*sb - 1 - i
-			ui := 18*sb + i
-			lb := f.mainData.Is[gr][ch][li]*cs[i] - f.mainData.Is[gr][ch][ui]*ca[i]
-			ub := f.mainData.Is[gr][ch][ui]*cs[i] + f.mainData.Is[gr][ch][li]*ca[i]
-			f.mainData.Is[gr][ch][li] = lb
-			f.mainData.Is[gr][ch][ui] = ub
-		}
+// subbands returns the number of the subbands to decode.
+func (f *Frame) subbands() int {
+	if f.fast {
+		return fastSubbands
 	}
+	return 32
 }
 
-func (f *Frame) hybridSynthesis(gr int, ch int) {
-	// Loop through all 32 subbands
-	for sb := 0; sb < 32; sb++ {
-		// Determine blocktype for this subband
-		bt := int(f.sideInfo.BlockType[gr][ch])
-		if (f.sideInfo.WinSwitchFlag[gr][ch] == 1) &&
-			(f.sideInfo.MixedBlockFlag[gr][ch] == 1) && (sb < 2) {
-			bt = 0
-		}
-		// Do the inverse modified DCT and windowing
-		in := make([]float32, 18)
-		for i := range in {
-			in[i] = f.mainData.Is[gr][ch][sb*18+i]
-		}
-		rawout := imdct.Win(in, bt)
-		// Overlapp add with stored vector into main_data vector
-		for i := 0; i < 18; i++ {
-			f.mainData.Is[gr][ch][sb*18+i] = rawout[i] + f.store[ch][sb][i]
-			f.store[ch][sb][i] = rawout[i+18]
-		}
+// count1 returns the number of the frequency lines of the granule gr and the channel ch to requantize.
+func (f *Frame) count1(gr, ch int) int {
+	n := f.sideInfo.Count1[gr][ch]
+	if m := f.subbands() * 18; n > m {
+		n = m
 	}
+	return n
 }
 
-func (f *Frame) frequencyInversion(gr int, ch int) {
-	for sb := 1; sb < 32; sb += 2 {
-		for i := 1; i < 18; i += 2 {
-			f.mainData.Is[gr][ch][sb*18+i] = -f.mainData.Is[gr][ch][sb*18+i]
-		}
+// putSample writes the 16-bit sample s of the channel ch to the first 4 bytes of out, which are a stereo sample.
+func putSample(out []byte, ch, nch int, s int16) {
+	if nch == 1 {
+		// We always run in stereo mode and duplicate channels here for mono.
+		out[0] = byte(s)
+		out[1] = byte(s >> 8)
+		out[2] = byte(s)
+		out[3] = byte(s >> 8)
+		return
 	}
-}
-
-var synthNWin = [64][32]float32{}
-
-func init() {
-	for i := 0; i < 64; i++ {
-		for j := 0; j < 32; j++ {
-			synthNWin[i][j] =
-				float32(math.Cos(float64((16+i)*(2*j+1)) * (math.Pi / 64.0)))
-		}
+	if ch == 0 {
+		out[0] = byte(s)
+		out[1] = byte(s >> 8)
+	} else {
+		out[2] = byte(s)
+		out[3] = byte(s >> 8)
 	}
 }
 
@@ -620,63 +490,3 @@ var synthDtbl = [512]float32{
 	0.000030518, 0.000030518, 0.000015259, 0.000015259,
 	0.000015259, 0.000015259, 0.000015259, 0.000015259,
 }
-
-func (f *Frame) subbandSynthesis(gr int, ch int, out []byte) {
-	u_vec := make([]float32, 512)
-	s_vec := make([]float32, 32)
-
-	nch := f.header.NumberOfChannels()
-	// Setup the n_win windowing vector and the v_vec intermediate vector
-	for ss := 0; ss < 18; ss++ { // Loop through 18 samples in 32 subbands
-		copy(f.v_vec[ch][64:1024], f.v_vec[ch][0:1024-64])
-		d := f.mainData.Is[gr][ch]
-		for i := 0; i < 32; i++ { // Copy next 32 time samples to a temp vector
-			s_vec[i] = d[i*18+ss]
-		}
-		for i := 0; i < 64; i++ { // Matrix multiply input with n_win[][] matrix
-			sum := float32(0)
-			for j := 0; j < 32; j++ {
-				sum += synthNWin[i][j] * s_vec[j]
-			}
-			f.v_vec[ch][i] = sum
-		}
-		v := f.v_vec[ch]
-		for i := 0; i < 512; i += 64 { // Build the U vector
-			copy(u_vec[i:i+32], v[(i<<1):(i<<1)+32])
-			copy(u_vec[i+32:i+64], v[(i<<1)+96:(i<<1)+128])
-		}
-		for i := 0; i < 512; i++ { // Window by u_vec[i] with synthDtbl[i]
-			u_vec[i] *= synthDtbl[i]
-		}
-		for i := 0; i < 32; i++ { // Calc 32 samples,store in outdata vector
-			sum := float32(0)
-			for j := 0; j < 512; j += 32 {
-				sum += u_vec[j+i]
-			}
-			// sum now contains time sample 32*ss+i. Convert to 16-bit signed int
-			samp := int(sum * 32767)
-			if samp > 32767 {
-				samp = 32767
-			} else if samp < -32767 {
-				samp = -32767
-			}
-			s := int16(samp)
-			idx := 4 * (32*ss + i)
-			if nch == 1 {
-				// We always run in stereo mode and duplicate channels here for mono.
-				out[idx] = byte(s)
-				out[idx+1] = byte(s >> 8)
-				out[idx+2] = byte(s)
-				out[idx+3] = byte(s >> 8)
-				continue
-			}
-			if ch == 0 {
-				out[idx] = byte(s)
-				out[idx+1] = byte(s >> 8)
-			} else {
-				out[idx+2] = byte(s)
-				out[idx+3] = byte(s >> 8)
-			}
-		}
-	}
-}
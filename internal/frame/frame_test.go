@@ -0,0 +1,87 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frame
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/maindata"
+	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
+)
+
+func TestSynthesisAllocs(t *testing.T) {
+	f := &Frame{
+		sideInfo: &sideinfo.SideInfo{},
+		mainData: &maindata.MainData{},
+	}
+	out := make([]byte, 4*576)
+	for _, bt := range []int{0, 2} {
+		f.sideInfo.BlockType[0][0] = bt
+		if n := testing.AllocsPerRun(10, func() {
+			f.hybridSynthesis(0, 0)
+			f.subbandSynthesis(0, 0, out)
+		}); n != 0 {
+			t.Errorf("block type %d: got: %f allocations, want: 0", bt, n)
+		}
+	}
+}
+
+type bytesReader struct {
+	buf []byte
+}
+
+func (r *bytesReader) ReadFull(buf []byte) (int, error) {
+	n := copy(buf, r.buf)
+	r.buf = r.buf[n:]
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func TestReuse(t *testing.T) {
+	buf, err := ioutil.ReadFile("../../example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Skip the ID3v2 tag.
+	r := &bytesReader{buf: buf[45:]}
+
+	var mainData []*maindata.MainData
+	var prev *Frame
+	var pcm []byte
+	for i := 0; i < 4; i++ {
+		f, _, err := Read(r, 0, prev, Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := f.DecodeTo(pcm)
+		if pcm != nil && &out[0] != &pcm[0] {
+			t.Errorf("frame %d: DecodeTo must reuse the buffer", i)
+		}
+		pcm = out
+		mainData = append(mainData, f.mainData)
+		if prev != nil && f != prev {
+			t.Errorf("frame %d: Read must reuse the previous frame", i)
+		}
+		prev = f
+	}
+	// The main data is used by two frames alternately.
+	if mainData[0] == mainData[1] || mainData[2] != mainData[0] || mainData[3] != mainData[1] {
+		t.Errorf("the main data is not reused")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3fixed
+// +build !mp3fixed
+
+package frame
+
+// synthMatrix and synthWindow are the kernels of the synthesis filterbank.
+// They are replaced with the SIMD implementations on some architectures.
+//
+// All the implementations must produce bit-identical results: the products are rounded before the additions, and
+// the additions are done in the same order.
+var (
+	synthMatrix = synthMatrixGo
+	synthWindow = synthWindowGo
+)
+
+// synthMatrixGo multiplies s by the synthNWin matrix and writes the 64 results to v[0:64].
+func synthMatrixGo(v []float32, s *[32]float32) {
+	v = v[:64]
+	for i := 0; i < 64; i++ { // Matrix multiply input with n_win[][] matrix
+		sum := float32(0)
+		for j := 0; j < 32; j++ {
+			// Round the product explicitly not to be fused into a multiply-add instruction.
+			sum += float32(synthNWin[i][j] * s[j])
+		}
+		v[i] = sum
+	}
+}
+
+// synthWindowGo windows the U vector built from v[0:1024] with synthDtbl, and writes the 32 samples to out.
+func synthWindowGo(out *[32]float32, v []float32) {
+	v = v[:1024]
+	for i := 0; i < 32; i++ {
+		sum := float32(0)
+		for j := 0; j < 16; j++ {
+			// The U vector consists of v[128n:128n+32] and v[128n+96:128n+128] for n in [0, 8).
+			k := 128*(j>>1) + 96*(j&1) + i
+			sum += float32(v[k] * synthDtbl[32*j+i])
+		}
+		out[i] = sum
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && !purego && !mp3fixed
+// +build amd64,!purego,!mp3fixed
+
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// func synthMatrixAVX2(v []float32, s *[32]float32)
+//
+// Y0-Y7 accumulate v[0:64]. For each j, s[j] times the row j of synthNWinT is added to them.
+TEXT ·synthMatrixAVX2(SB), NOSPLIT, $0-32
+	MOVQ v_base+0(FP), DI
+	MOVQ s+24(FP), SI
+	LEAQ ·synthNWinT(SB), DX
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS Y2, Y2, Y2
+	VXORPS Y3, Y3, Y3
+	VXORPS Y4, Y4, Y4
+	VXORPS Y5, Y5, Y5
+	VXORPS Y6, Y6, Y6
+	VXORPS Y7, Y7, Y7
+	MOVQ $32, CX
+
+matrixLoop:
+	VBROADCASTSS (SI), Y8
+	VMULPS 0(DX), Y8, Y9
+	VADDPS Y9, Y0, Y0
+	VMULPS 32(DX), Y8, Y9
+	VADDPS Y9, Y1, Y1
+	VMULPS 64(DX), Y8, Y9
+	VADDPS Y9, Y2, Y2
+	VMULPS 96(DX), Y8, Y9
+	VADDPS Y9, Y3, Y3
+	VMULPS 128(DX), Y8, Y9
+	VADDPS Y9, Y4, Y4
+	VMULPS 160(DX), Y8, Y9
+	VADDPS Y9, Y5, Y5
+	VMULPS 192(DX), Y8, Y9
+	VADDPS Y9, Y6, Y6
+	VMULPS 224(DX), Y8, Y9
+	VADDPS Y9, Y7, Y7
+	ADDQ $4, SI
+	ADDQ $256, DX
+	DECQ CX
+	JNZ  matrixLoop
+
+	VMOVUPS Y0, 0(DI)
+	VMOVUPS Y1, 32(DI)
+	VMOVUPS Y2, 64(DI)
+	VMOVUPS Y3, 96(DI)
+	VMOVUPS Y4, 128(DI)
+	VMOVUPS Y5, 160(DI)
+	VMOVUPS Y6, 192(DI)
+	VMOVUPS Y7, 224(DI)
+	VZEROUPPER
+	RET
+
+// func synthWindowAVX2(out *[32]float32, v []float32)
+//
+// Y0-Y3 accumulate out[0:32]. Each iteration adds the two 32-value parts of the U vector, v[128n:128n+32] and
+// v[128n+96:128n+128], multiplied by synthDtbl.
+TEXT ·synthWindowAVX2(SB), NOSPLIT, $0-32
+	MOVQ out+0(FP), DI
+	MOVQ v_base+8(FP), SI
+	LEAQ ·synthDtbl(SB), DX
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS Y2, Y2, Y2
+	VXORPS Y3, Y3, Y3
+	MOVQ $8, CX
+
+windowLoop:
+	VMOVUPS 0(SI), Y4
+	VMULPS  0(DX), Y4, Y4
+	VADDPS  Y4, Y0, Y0
+	VMOVUPS 32(SI), Y4
+	VMULPS  32(DX), Y4, Y4
+	VADDPS  Y4, Y1, Y1
+	VMOVUPS 64(SI), Y4
+	VMULPS  64(DX), Y4, Y4
+	VADDPS  Y4, Y2, Y2
+	VMOVUPS 96(SI), Y4
+	VMULPS  96(DX), Y4, Y4
+	VADDPS  Y4, Y3, Y3
+
+	VMOVUPS 384(SI), Y4
+	VMULPS  128(DX), Y4, Y4
+	VADDPS  Y4, Y0, Y0
+	VMOVUPS 416(SI), Y4
+	VMULPS  160(DX), Y4, Y4
+	VADDPS  Y4, Y1, Y1
+	VMOVUPS 448(SI), Y4
+	VMULPS  192(DX), Y4, Y4
+	VADDPS  Y4, Y2, Y2
+	VMOVUPS 480(SI), Y4
+	VMULPS  224(DX), Y4, Y4
+	VADDPS  Y4, Y3, Y3
+
+	ADDQ $512, SI
+	ADDQ $256, DX
+	DECQ CX
+	JNZ  windowLoop
+
+	VMOVUPS Y0, 0(DI)
+	VMOVUPS Y1, 32(DI)
+	VMOVUPS Y2, 64(DI)
+	VMOVUPS Y3, 96(DI)
+	VZEROUPPER
+	RET
@@ -0,0 +1,30 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64 && !purego && !mp3fixed
+// +build arm64,!purego,!mp3fixed
+
+package frame
+
+// NEON is always available on arm64.
+func init() {
+	synthMatrix = synthMatrixNEON
+	synthWindow = synthWindowNEON
+}
+
+//go:noescape
+func synthMatrixNEON(v []float32, s *[32]float32)
+
+//go:noescape
+func synthWindowNEON(out *[32]float32, v []float32)
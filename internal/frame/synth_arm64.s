@@ -0,0 +1,172 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64 && !purego && !mp3fixed
+// +build arm64,!purego,!mp3fixed
+
+#include "textflag.h"
+
+// The assembler doesn't support the vector floating-point arithmetic instructions.
+// FMUL Vd.4S, Vn.4S, Vm.4S
+#define VFMUL4S(m, n, d) WORD $(0x6E20DC00 | (m)<<16 | (n)<<5 | (d))
+// FADD Vd.4S, Vn.4S, Vm.4S
+#define VFADD4S(m, n, d) WORD $(0x4E20D400 | (m)<<16 | (n)<<5 | (d))
+
+// func synthMatrixNEON(v []float32, s *[32]float32)
+//
+// V0-V15 accumulate v[0:64]. For each j, s[j] times the row j of synthNWinT is added to them.
+TEXT ·synthMatrixNEON(SB), NOSPLIT, $0-32
+	MOVD v_base+0(FP), R0
+	MOVD s+24(FP), R1
+	MOVD $·synthNWinT(SB), R2
+	VEOR V0.B16, V0.B16, V0.B16
+	VEOR V1.B16, V1.B16, V1.B16
+	VEOR V2.B16, V2.B16, V2.B16
+	VEOR V3.B16, V3.B16, V3.B16
+	VEOR V4.B16, V4.B16, V4.B16
+	VEOR V5.B16, V5.B16, V5.B16
+	VEOR V6.B16, V6.B16, V6.B16
+	VEOR V7.B16, V7.B16, V7.B16
+	VEOR V8.B16, V8.B16, V8.B16
+	VEOR V9.B16, V9.B16, V9.B16
+	VEOR V10.B16, V10.B16, V10.B16
+	VEOR V11.B16, V11.B16, V11.B16
+	VEOR V12.B16, V12.B16, V12.B16
+	VEOR V13.B16, V13.B16, V13.B16
+	VEOR V14.B16, V14.B16, V14.B16
+	VEOR V15.B16, V15.B16, V15.B16
+	MOVD $32, R3
+
+matrixLoop:
+	VLD1R.P 4(R1), [V16.S4]
+
+	VLD1.P 64(R2), [V17.S4, V18.S4, V19.S4, V20.S4]
+	VFMUL4S(16, 17, 17)
+	VFMUL4S(16, 18, 18)
+	VFMUL4S(16, 19, 19)
+	VFMUL4S(16, 20, 20)
+	VFADD4S(17, 0, 0)
+	VFADD4S(18, 1, 1)
+	VFADD4S(19, 2, 2)
+	VFADD4S(20, 3, 3)
+
+	VLD1.P 64(R2), [V17.S4, V18.S4, V19.S4, V20.S4]
+	VFMUL4S(16, 17, 17)
+	VFMUL4S(16, 18, 18)
+	VFMUL4S(16, 19, 19)
+	VFMUL4S(16, 20, 20)
+	VFADD4S(17, 4, 4)
+	VFADD4S(18, 5, 5)
+	VFADD4S(19, 6, 6)
+	VFADD4S(20, 7, 7)
+
+	VLD1.P 64(R2), [V17.S4, V18.S4, V19.S4, V20.S4]
+	VFMUL4S(16, 17, 17)
+	VFMUL4S(16, 18, 18)
+	VFMUL4S(16, 19, 19)
+	VFMUL4S(16, 20, 20)
+	VFADD4S(17, 8, 8)
+	VFADD4S(18, 9, 9)
+	VFADD4S(19, 10, 10)
+	VFADD4S(20, 11, 11)
+
+	VLD1.P 64(R2), [V17.S4, V18.S4, V19.S4, V20.S4]
+	VFMUL4S(16, 17, 17)
+	VFMUL4S(16, 18, 18)
+	VFMUL4S(16, 19, 19)
+	VFMUL4S(16, 20, 20)
+	VFADD4S(17, 12, 12)
+	VFADD4S(18, 13, 13)
+	VFADD4S(19, 14, 14)
+	VFADD4S(20, 15, 15)
+
+	SUB  $1, R3
+	CBNZ R3, matrixLoop
+
+	VST1.P [V0.S4, V1.S4, V2.S4, V3.S4], 64(R0)
+	VST1.P [V4.S4, V5.S4, V6.S4, V7.S4], 64(R0)
+	VST1.P [V8.S4, V9.S4, V10.S4, V11.S4], 64(R0)
+	VST1   [V12.S4, V13.S4, V14.S4, V15.S4], (R0)
+	RET
+
+// func synthWindowNEON(out *[32]float32, v []float32)
+//
+// V0-V7 accumulate out[0:32]. Each iteration adds the two 32-value parts of the U vector, v[128n:128n+32] and
+// v[128n+96:128n+128], multiplied by synthDtbl.
+TEXT ·synthWindowNEON(SB), NOSPLIT, $0-32
+	MOVD out+0(FP), R0
+	MOVD v_base+8(FP), R1
+	MOVD $·synthDtbl(SB), R2
+	VEOR V0.B16, V0.B16, V0.B16
+	VEOR V1.B16, V1.B16, V1.B16
+	VEOR V2.B16, V2.B16, V2.B16
+	VEOR V3.B16, V3.B16, V3.B16
+	VEOR V4.B16, V4.B16, V4.B16
+	VEOR V5.B16, V5.B16, V5.B16
+	VEOR V6.B16, V6.B16, V6.B16
+	VEOR V7.B16, V7.B16, V7.B16
+	MOVD $8, R3
+
+windowLoop:
+	MOVD R1, R4
+	VLD1.P 64(R4), [V8.S4, V9.S4, V10.S4, V11.S4]
+	VLD1.P 64(R2), [V12.S4, V13.S4, V14.S4, V15.S4]
+	VFMUL4S(12, 8, 8)
+	VFMUL4S(13, 9, 9)
+	VFMUL4S(14, 10, 10)
+	VFMUL4S(15, 11, 11)
+	VFADD4S(8, 0, 0)
+	VFADD4S(9, 1, 1)
+	VFADD4S(10, 2, 2)
+	VFADD4S(11, 3, 3)
+	VLD1.P 64(R4), [V8.S4, V9.S4, V10.S4, V11.S4]
+	VLD1.P 64(R2), [V12.S4, V13.S4, V14.S4, V15.S4]
+	VFMUL4S(12, 8, 8)
+	VFMUL4S(13, 9, 9)
+	VFMUL4S(14, 10, 10)
+	VFMUL4S(15, 11, 11)
+	VFADD4S(8, 4, 4)
+	VFADD4S(9, 5, 5)
+	VFADD4S(10, 6, 6)
+	VFADD4S(11, 7, 7)
+
+	ADD    $384, R1, R4
+	VLD1.P 64(R4), [V8.S4, V9.S4, V10.S4, V11.S4]
+	VLD1.P 64(R2), [V12.S4, V13.S4, V14.S4, V15.S4]
+	VFMUL4S(12, 8, 8)
+	VFMUL4S(13, 9, 9)
+	VFMUL4S(14, 10, 10)
+	VFMUL4S(15, 11, 11)
+	VFADD4S(8, 0, 0)
+	VFADD4S(9, 1, 1)
+	VFADD4S(10, 2, 2)
+	VFADD4S(11, 3, 3)
+	VLD1.P 64(R4), [V8.S4, V9.S4, V10.S4, V11.S4]
+	VLD1.P 64(R2), [V12.S4, V13.S4, V14.S4, V15.S4]
+	VFMUL4S(12, 8, 8)
+	VFMUL4S(13, 9, 9)
+	VFMUL4S(14, 10, 10)
+	VFMUL4S(15, 11, 11)
+	VFADD4S(8, 4, 4)
+	VFADD4S(9, 5, 5)
+	VFADD4S(10, 6, 6)
+	VFADD4S(11, 7, 7)
+
+	ADD  $512, R1
+	SUB  $1, R3
+	CBNZ R3, windowLoop
+
+	VST1.P [V0.S4, V1.S4, V2.S4, V3.S4], 64(R0)
+	VST1   [V4.S4, V5.S4, V6.S4, V7.S4], (R0)
+	RET
@@ -0,0 +1,54 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3fixed
+// +build !mp3fixed
+
+package frame
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSynthKernels(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var v [1024]float32
+	for i := range v {
+		v[i] = float32(r.NormFloat64() * 1000)
+	}
+	var s [32]float32
+	for i := range s {
+		s[i] = float32(r.NormFloat64())
+	}
+
+	got, want := v, v
+	synthMatrix(got[:], &s)
+	synthMatrixGo(want[:], &s)
+	for i := range got {
+		if math.Float32bits(got[i]) != math.Float32bits(want[i]) {
+			t.Errorf("synthMatrix: v[%d]: got: %v, want: %v", i, got[i], want[i])
+		}
+	}
+
+	var gotOut, wantOut [32]float32
+	synthWindow(&gotOut, v[:])
+	synthWindowGo(&wantOut, v[:])
+	for i := range gotOut {
+		if math.Float32bits(gotOut[i]) != math.Float32bits(wantOut[i]) {
+			t.Errorf("synthWindow: out[%d]: got: %v, want: %v", i, gotOut[i], wantOut[i])
+		}
+	}
+}
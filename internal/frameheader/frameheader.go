@@ -15,7 +15,7 @@
 package frameheader
 
 import (
-	"errors"
+	"encoding/binary"
 	"fmt"
 	"io"
 
@@ -30,6 +30,13 @@ func (f FrameHeader) ID() consts.Version {
 	return consts.Version((f & 0x00180000) >> 19)
 }
 
+// HasReservedVersion reports whether f has the frame sync and the reserved version bits. Such a header is not valid,
+// but it indicates an unsupported MPEG version rather than garbage.
+func (f FrameHeader) HasReservedVersion() bool {
+	const sync = 0xffe00000
+	return f&sync == sync && f.ID() == consts.VersionReserved
+}
+
 // Layer returns the mpeg layer of this frame stored in position 18,17
 func (f FrameHeader) Layer() consts.Layer {
 	return consts.Layer((f & 0x00060000) >> 17)
@@ -51,15 +58,19 @@ func (f FrameHeader) SamplingFrequency() consts.SamplingFrequency {
 }
 
 func (f FrameHeader) SamplingFrequencyValue() (int, error) {
+	shift := uint(f.LowSamplingFrequency())
+	if f.ID() == consts.Version2_5 {
+		shift = 2
+	}
 	switch f.SamplingFrequency() {
 	case 0:
-		return 44100 >> uint(f.LowSamplingFrequency()), nil
+		return 44100 >> shift, nil
 	case 1:
-		return 48000 >> uint(f.LowSamplingFrequency()), nil
+		return 48000 >> shift, nil
 	case 2:
-		return 32000 >> uint(f.LowSamplingFrequency()), nil
+		return 32000 >> shift, nil
 	}
-	return 0, errors.New("mp3: frame header has invalid sample frequency")
+	return 0, fmt.Errorf("%w: reserved sample frequency", consts.ErrInvalidHeader)
 }
 
 // PaddingBit returns the padding bit stored in position 9
@@ -122,6 +133,19 @@ func (f FrameHeader) LowSamplingFrequency() int {
 	return 1
 }
 
+// SfBandIndices returns the scale factor band boundaries for long and short blocks.
+func (f FrameHeader) SfBandIndices() (long, short []int) {
+	v := 0
+	switch f.ID() {
+	case consts.Version2:
+		v = 1
+	case consts.Version2_5:
+		v = 2
+	}
+	t := consts.SfBandIndices[v][f.SamplingFrequency()]
+	return t[consts.SfBandIndicesLong], t[consts.SfBandIndicesShort]
+}
+
 func (f FrameHeader) BytesPerFrame() int {
 	return consts.SamplesPerGr * f.Granules() * 4
 }
@@ -132,6 +156,34 @@ func (f FrameHeader) Granules() int {
 
 // IsValid returns a boolean value indicating whether the header is valid or not.
 func (f FrameHeader) IsValid() bool {
+	return f.IsValidWithOptions(Options{})
+}
+
+// Options represents options to validate and read frame headers.
+type Options struct {
+	// AcceptReservedEmphasis specifies whether headers with the reserved emphasis value 2 are valid.
+	AcceptReservedEmphasis bool
+
+	// MaxResyncBytes is the maximum number of bytes Read skips to find a valid frame header.
+	// ID3v2 tags skipped wholesale are not counted. If MaxResyncBytes is 0, the number is not limited.
+	MaxResyncBytes int
+
+	// DisallowResync specifies whether skipping any byte to find a valid frame header is an error.
+	// ID3v2 tags skipped wholesale are allowed.
+	DisallowResync bool
+
+	// SyncConfirmations is the number of the following frame headers that must be valid and consistent to accept a
+	// frame header found by skipping bytes. This reduces false syncs in garbage like broken tags.
+	// If SyncConfirmations is 0, a frame header is accepted by itself.
+	SyncConfirmations int
+}
+
+// MaxFrameSize is the maximum size of a frame in bytes. A frame of MPEG audio is at most 1728 bytes (MPEG-1 Layer
+// II, 384 kbps, 32000 Hz).
+const MaxFrameSize = 2000
+
+// IsValidWithOptions is like IsValid but takes options.
+func (f FrameHeader) IsValidWithOptions(options Options) bool {
 	const sync = 0xffe00000
 	if (f & sync) != sync {
 		return false
@@ -148,7 +200,12 @@ func (f FrameHeader) IsValid() bool {
 	if f.Layer() == consts.LayerReserved {
 		return false
 	}
-	if f.Emphasis() == 2 {
+	if f.Emphasis() == 2 && !options.AcceptReservedEmphasis {
+		return false
+	}
+	// An implausible frame size indicates a false sync, e.g. a non-standard MPEG-2.5 Layer II header in garbage.
+	// Such a header is not accepted so that the scan continues to the next header instead of failing to decode.
+	if size, err := f.FrameSize(); err != nil || size > MaxFrameSize {
 		return false
 	}
 	return true
@@ -191,9 +248,15 @@ func (f FrameHeader) FrameSize() (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	size := ((144*f.Bitrate())/freq +
-		int(f.PaddingBit())) >> uint(f.LowSamplingFrequency())
-	return size, nil
+	switch f.Layer() {
+	case consts.Layer1:
+		return (12*f.Bitrate()/freq + f.PaddingBit()) * 4, nil
+	case consts.Layer2:
+		return 144*f.Bitrate()/freq + f.PaddingBit(), nil
+	}
+	// Layer III frames of MPEG-2 and MPEG-2.5 have half the samples of MPEG-1 frames.
+	// Note that the padding is not halved.
+	return (144>>uint(f.LowSamplingFrequency()))*f.Bitrate()/freq + f.PaddingBit(), nil
 }
 
 func (f FrameHeader) SideInfoSize() int {
@@ -226,8 +289,18 @@ type FullReader interface {
 	ReadFull([]byte) (int, error)
 }
 
-func Read(source FullReader, position int64) (h FrameHeader, startPosition int64, err error) {
-	buf := make([]byte, 4)
+// Read reads a frame header from source.
+//
+// Read skips bytes until a valid frame header is found. ID3v2 tags embedded in the stream, e.g. in concatenated
+// files, are skipped wholesale.
+//
+// buf is the buffer to read the header bytes into, which must be nil or at least 4 bytes. If buf is nil, Read
+// allocates it.
+func Read(source FullReader, position int64, options Options, buf []byte) (h FrameHeader, startPosition int64, err error) {
+	if buf == nil {
+		buf = make([]byte, 4)
+	}
+	buf = buf[:4]
 	if n, err := source.ReadFull(buf); n < 4 {
 		if err == io.EOF {
 			if n == 0 {
@@ -239,34 +312,291 @@ func Read(source FullReader, position int64) (h FrameHeader, startPosition int64
 		return 0, 0, err
 	}
 
+	// pending is the bytes read ahead that are not scanned yet.
+	var pending []byte
+	// chunk is the buffer to read ahead. chunk is allocated when bytes are skipped first.
+	var chunk []byte
+	readByte := func() (byte, error) {
+		if len(pending) == 0 {
+			if _, ok := source.(unreader); ok {
+				// Read ahead in chunks so that skipping garbage doesn't read byte by byte. The bytes read ahead
+				// are given back at the end.
+				if chunk == nil {
+					chunk = make([]byte, resyncChunkSize)
+				}
+				n, err := source.ReadFull(chunk)
+				if n == 0 {
+					return 0, err
+				}
+				pending = chunk[:n]
+			} else {
+				if chunk == nil {
+					chunk = make([]byte, 1)
+				}
+				if _, err := source.ReadFull(chunk); err != nil {
+					return 0, err
+				}
+				pending = chunk
+			}
+		}
+		b := pending[0]
+		pending = pending[1:]
+		return b, nil
+	}
+
+	// skipped is the number of bytes skipped to find a frame header.
+	skipped := 0
+
 	b1 := uint32(buf[0])
 	b2 := uint32(buf[1])
 	b3 := uint32(buf[2])
 	b4 := uint32(buf[3])
 	header := FrameHeader((b1 << 24) | (b2 << 16) | (b3 << 8) | (b4 << 0))
-	for !header.IsValid() {
-		b1 = b2
-		b2 = b3
-		b3 = b4
+	for {
+		if header.IsValidWithOptions(options) {
+			// A frame header found by skipping bytes might be a false sync in garbage.
+			if skipped == 0 || options.SyncConfirmations <= 0 {
+				break
+			}
+			ok, err := confirmSync(source, header, &pending, options)
+			if err != nil {
+				return 0, 0, err
+			}
+			if ok {
+				break
+			}
+		} else if b1 == 'I' && b2 == 'D' && b3 == '3' {
+			rest := make([]byte, id3v2HeaderSize-4)
+			n := copy(rest, pending)
+			pending = pending[n:]
+			if n < len(rest) {
+				m, err := source.ReadFull(rest[n:])
+				if err != nil && err != io.EOF {
+					return 0, 0, err
+				}
+				n += m
+			}
+			rest = rest[:n]
+			if size, ok := id3v2Size(byte(b4), rest); ok {
+				n := size - id3v2HeaderSize
+				if len(pending) >= n {
+					pending = pending[n:]
+					n = 0
+				} else {
+					n -= len(pending)
+					pending = nil
+				}
+				if err := discard(source, n); err != nil {
+					if err == io.EOF {
+						return 0, 0, &consts.UnexpectedEOF{"readHeader (3)"}
+					}
+					return 0, 0, err
+				}
+				position += int64(size)
+				n = copy(buf, pending)
+				pending = pending[n:]
+				m, err := source.ReadFull(buf[n:])
+				if n += m; n < 4 {
+					if err == io.EOF {
+						if n == 0 {
+							// The stream ends with the tag.
+							return 0, 0, io.EOF
+						}
+						return 0, 0, &consts.UnexpectedEOF{"readHeader (4)"}
+					}
+					return 0, 0, err
+				}
+				b1 = uint32(buf[0])
+				b2 = uint32(buf[1])
+				b3 = uint32(buf[2])
+				b4 = uint32(buf[3])
+				header = FrameHeader((b1 << 24) | (b2 << 16) | (b3 << 8) | (b4 << 0))
+				continue
+			}
+			// This is not an ID3v2 tag. Scan the read bytes as usual.
+			pending = append(rest, pending...)
+		}
+
+		// extra is the number of the bytes skipped at once in addition to the byte shifted below.
+		extra := 0
+		if !options.DisallowResync && !isSyncCandidate(b2) && !isSyncCandidate(b3) && !isSyncCandidate(b4) &&
+			len(pending) >= 3 {
+			// The bytes before a candidate can't start a frame header nor an ID3v2 tag. Skip them to make
+			// pending[i:i+3] the next b1, b2 and b3.
+			i := indexSyncCandidate(pending)
+			if i < 0 || i > len(pending)-3 {
+				i = len(pending) - 3
+			}
+			// Stop at the limit so that the error is the same as skipping byte by byte.
+			if options.MaxResyncBytes > 0 && skipped+i+4 > options.MaxResyncBytes {
+				i = options.MaxResyncBytes - skipped - 4
+			}
+			if i > 0 {
+				extra = i + 3
+				b1 = uint32(pending[i])
+				b2 = uint32(pending[i+1])
+				b3 = uint32(pending[i+2])
+				pending = pending[i+3:]
+			}
+		}
+		if extra == 0 {
+			b1 = b2
+			b2 = b3
+			b3 = b4
+		}
 
-		buf := make([]byte, 1)
-		if _, err := source.ReadFull(buf); err != nil {
+		b, err := readByte()
+		if err != nil {
 			if err == io.EOF {
 				return 0, 0, &consts.UnexpectedEOF{"readHeader (2)"}
 			}
 			return 0, 0, err
 		}
-		b4 = uint32(buf[0])
+		b4 = uint32(b)
 		header = FrameHeader((b1 << 24) | (b2 << 16) | (b3 << 8) | (b4 << 0))
-		position++
+		position += int64(1 + extra)
+
+		skipped += 1 + extra
+		if options.DisallowResync {
+			return 0, 0, fmt.Errorf("%w: at position %d", consts.ErrResync, position-1)
+		}
+		if options.MaxResyncBytes > 0 && skipped > options.MaxResyncBytes {
+			return 0, 0, fmt.Errorf("%w: no valid frame header in %d bytes before position %d",
+				consts.ErrNoSync, options.MaxResyncBytes, position)
+		}
+	}
+	// Give back the bytes read ahead.
+	if len(pending) > 0 {
+		if u, ok := source.(unreader); ok {
+			u.Unread(pending)
+		}
 	}
 
 	// If we get here we've found the sync word, and can decode the header
 	// which is in the low 20 bits of the 32-bit sync+header word.
 
 	if header.BitrateIndex() == 0 {
-		return 0, 0, fmt.Errorf("mp3: free bitrate format is not supported. Header word is 0x%08x at position %d",
-			header, position)
+		return 0, 0, fmt.Errorf("%w: header word is 0x%08x at position %d",
+			consts.ErrFreeBitrate, header, position)
 	}
 	return header, position, nil
 }
+
+// resyncChunkSize is the size of a read ahead to skip garbage.
+const resyncChunkSize = 4096
+
+// isSyncCandidate reports whether b can be the first byte of a frame header or an ID3v2 tag.
+func isSyncCandidate(b uint32) bool {
+	return b == 0xff || b == 'I'
+}
+
+// indexSyncCandidate returns the index of the first byte in buf that can be the first byte of a frame header or an
+// ID3v2 tag, or -1.
+func indexSyncCandidate(buf []byte) int {
+	for i, b := range buf {
+		if b == 0xff || b == 'I' {
+			return i
+		}
+	}
+	return -1
+}
+
+// unreader is implemented by a source that can give back bytes read ahead.
+type unreader interface {
+	Unread(buf []byte)
+}
+
+// confirmSync reports whether the options.SyncConfirmations frame headers following h are valid and consistent
+// with h. The bytes read ahead are appended to pending so that they are scanned or given back later.
+//
+// confirmSync reports true when the stream ends before the following frame headers, or when source can't give
+// back the bytes read ahead.
+func confirmSync(source FullReader, h FrameHeader, pending *[]byte, options Options) (bool, error) {
+	if _, ok := source.(unreader); !ok {
+		return true, nil
+	}
+
+	// The bits of the sync word, the version, the layer and the sampling frequency must match.
+	const mask = 0xfffe0c00
+
+	// end is the end of the current frame header in pending.
+	end := 0
+	for i := 0; i < options.SyncConfirmations; i++ {
+		if h.BitrateIndex() == 0 {
+			// The frame size is unknown in the free bitrate format.
+			return true, nil
+		}
+		size, err := h.FrameSize()
+		if err != nil || size < 4 {
+			return false, nil
+		}
+		end += size
+		if len(*pending) < end {
+			buf := make([]byte, end-len(*pending))
+			n, err := source.ReadFull(buf)
+			*pending = append(*pending, buf[:n]...)
+			if n < len(buf) {
+				if err == io.EOF {
+					return true, nil
+				}
+				return false, err
+			}
+		}
+		next := FrameHeader(binary.BigEndian.Uint32((*pending)[end-4:]))
+		if !next.IsValidWithOptions(options) || uint32(next)&mask != uint32(h)&mask {
+			return false, nil
+		}
+		h = next
+	}
+	return true, nil
+}
+
+const (
+	// id3v2HeaderSize is the size of an ID3v2 header and of an ID3v2.4 footer.
+	id3v2HeaderSize = 10
+
+	// id3v2FlagFooter is the ID3v2.4 header flag indicating that a footer follows the tag.
+	id3v2FlagFooter = 0x10
+)
+
+// id3v2Size returns the whole size of an ID3v2 tag including its header and footer.
+// majorVersion is the 4th byte of the header and rest is the remaining 6 bytes of the header.
+//
+// id3v2Size returns false when the bytes are not a valid ID3v2 header.
+func id3v2Size(majorVersion byte, rest []byte) (int, bool) {
+	if len(rest) < id3v2HeaderSize-4 {
+		return 0, false
+	}
+	if majorVersion < 2 || majorVersion > 4 || rest[0] == 0xff {
+		return 0, false
+	}
+	size := 0
+	for _, b := range rest[2:6] {
+		if b&0x80 != 0 {
+			return 0, false
+		}
+		size = size<<7 | int(b)
+	}
+	size += id3v2HeaderSize
+	if majorVersion == 4 && rest[1]&id3v2FlagFooter != 0 {
+		size += id3v2HeaderSize
+	}
+	return size, true
+}
+
+// discard reads and discards n bytes from source.
+func discard(source FullReader, n int) error {
+	buf := make([]byte, 4096)
+	for n > 0 {
+		b := buf
+		if len(b) > n {
+			b = b[:n]
+		}
+		m, err := source.ReadFull(b)
+		if err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
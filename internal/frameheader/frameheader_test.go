@@ -0,0 +1,193 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frameheader_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/internal/frameheader"
+)
+
+func TestFrameSize(t *testing.T) {
+	cases := []struct {
+		header FrameHeader
+		size   int
+	}{
+		// MPEG-1 Layer III, 128 kbps, 44100 Hz
+		{0xfffb9064, 417},
+		{0xfffb9264, 418},
+		// MPEG-2 Layer III, 48 kbps, 22050 Hz
+		{0xfff360c4, 156},
+		{0xfff362c4, 157},
+		// MPEG-2 Layer III, 24 kbps, 22050 Hz
+		{0xfff330c4, 78},
+		{0xfff332c4, 79},
+		// MPEG-2.5 Layer III, 32 kbps, 11025 Hz
+		{0xffe340c4, 208},
+		{0xffe342c4, 209},
+		// MPEG-2.5 Layer III, 32 kbps, 8000 Hz
+		{0xffe348c4, 288},
+	}
+	for _, c := range cases {
+		got, err := c.header.FrameSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.size {
+			t.Errorf("FrameSize(%08x): got: %d, want: %d", uint32(c.header), got, c.size)
+		}
+	}
+}
+
+func TestSamplingFrequencyValue(t *testing.T) {
+	cases := []struct {
+		header FrameHeader
+		freq   int
+	}{
+		{0xfffb9064, 44100},
+		{0xfffb9464, 48000},
+		{0xfffb9864, 32000},
+		{0xfff360c4, 22050},
+		{0xfff364c4, 24000},
+		{0xfff368c4, 16000},
+		{0xffe340c4, 11025},
+		{0xffe344c4, 12000},
+		{0xffe348c4, 8000},
+	}
+	for _, c := range cases {
+		if !c.header.IsValid() {
+			t.Errorf("IsValid(%08x): got: false, want: true", uint32(c.header))
+		}
+		got, err := c.header.SamplingFrequencyValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.freq {
+			t.Errorf("SamplingFrequencyValue(%08x): got: %d, want: %d", uint32(c.header), got, c.freq)
+		}
+	}
+}
+
+type bytesReader struct {
+	buf []byte
+}
+
+func (r *bytesReader) ReadFull(buf []byte) (int, error) {
+	n := copy(buf, r.buf)
+	r.buf = r.buf[n:]
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func TestImplausibleFrameSize(t *testing.T) {
+	// MPEG-2.5 Layer II, 160 kbps, 8000 Hz is 2880 bytes per frame.
+	h := FrameHeader(0xffe5e8c0)
+	if size, err := h.FrameSize(); err != nil || size <= MaxFrameSize {
+		t.Fatalf("FrameSize(%08x): got: %d, %v, want: > %d", uint32(h), size, err, MaxFrameSize)
+	}
+	if h.IsValid() {
+		t.Errorf("IsValid(%08x): got: true, want: false", uint32(h))
+	}
+
+	// The header is skipped to find the next header.
+	next := FrameHeader(0xfffb9064)
+	buf := []byte{0xff, 0xe5, 0xe8, 0xc0, 0xff, 0xfb, 0x90, 0x64}
+	got, pos, err := Read(&bytesReader{buf}, 0, Options{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != next || pos != 4 {
+		t.Errorf("Read: got: %08x at %d, want: %08x at 4", uint32(got), pos, uint32(next))
+	}
+}
+
+// unreadReader is a bytesReader that can give back bytes read ahead.
+type unreadReader struct {
+	bytesReader
+}
+
+func (r *unreadReader) Unread(buf []byte) {
+	r.buf = append(append([]byte{}, buf...), r.buf...)
+}
+
+func TestResyncGarbage(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	garbage := func(n int) []byte {
+		buf := make([]byte, n)
+		r.Read(buf)
+		for i := range buf {
+			switch {
+			case buf[i] == 0xff && i+1 < n:
+				// An invalid sync.
+				buf[i+1] = 0
+			case i%1000 == 999 && i+10 < n:
+				// Not an ID3v2 tag.
+				copy(buf[i-3:], "ID3\x09")
+			}
+		}
+		return buf
+	}
+	g1 := garbage(1 << 20)
+	g2 := garbage(10000)
+
+	var src []byte
+	src = append(src, g1...)
+	src = append(src, "ID3\x04\x00\x00\x00\x00\x00\x14"...)
+	src = append(src, make([]byte, 0x14)...)
+	src = append(src, g2...)
+	header := FrameHeader(0xfffb9064)
+	src = append(src, 0xff, 0xfb, 0x90, 0x64, 1, 2, 3)
+	want := int64(len(src) - 7)
+
+	for _, max := range []int{0, len(g1) + len(g2), len(g1) + len(g2) - 1, 5000} {
+		options := Options{MaxResyncBytes: max}
+		results := make([]string, 2)
+		for i, unread := range []bool{false, true} {
+			var source FullReader = &bytesReader{src}
+			if unread {
+				source = &unreadReader{bytesReader{src}}
+			}
+			h, pos, err := Read(source, 0, options, nil)
+			results[i] = fmt.Sprintf("%08x at %d, %v", uint32(h), pos, err)
+			if max != 0 && max < len(g1)+len(g2) {
+				if err == nil {
+					t.Errorf("MaxResyncBytes %d, unread %t: Read must fail", max, unread)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if h != header || pos != want {
+				t.Errorf("MaxResyncBytes %d, unread %t: got: %08x at %d, want: %08x at %d", max, unread, uint32(h), pos, uint32(header), want)
+			}
+			// The bytes read ahead must be given back.
+			rest := make([]byte, 4)
+			if n, _ := source.ReadFull(rest); !bytes.Equal(rest[:n], []byte{1, 2, 3}) {
+				t.Errorf("MaxResyncBytes %d, unread %t: the next bytes: got: %v, want: [1 2 3]", max, unread, rest[:n])
+			}
+		}
+		// Skipping garbage at once must give the same result as skipping byte by byte.
+		if results[0] != results[1] {
+			t.Errorf("MaxResyncBytes %d: got: %q and %q", max, results[0], results[1])
+		}
+	}
+}
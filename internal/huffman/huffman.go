@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/hajimehoshi/go-mp3/internal/bits"
+	"github.com/hajimehoshi/go-mp3/internal/consts"
 )
 
 var huffmanTable = []uint16{
@@ -345,45 +346,135 @@ var huffmanMain = [...]huffTables{
 	{huffmanTable[2773:], 31, 0},   // Table 33
 }
 
-func Decode(m *bits.Bits, table_num int) (x, y, v, w int, err error) {
-	point := 0
-	error := 1
-	bitsleft := 32
-	treelen := huffmanMain[table_num].treelen
-	linbits := huffmanMain[table_num].linbits
-	if treelen == 0 { // Check for empty tables
-		return 0, 0, 0, 0, nil
+// lookupBits is the number of the bits decoded at once with the lookup tables.
+// Most code words are not longer than lookupBits.
+const lookupBits = 8
+
+// lookupInvalid is the length of an entry for the bits that are not a valid code word.
+const lookupInvalid = 0xff
+
+// lookupEntry is an entry of a lookup table indexed by the next lookupBits bits.
+type lookupEntry struct {
+	// value is the decoded value like htptr[point] when length is between 1 and lookupBits.
+	value uint16
+
+	// point is the position in the tree after lookupBits bits when length is 0, i.e. the code word is longer than
+	// lookupBits.
+	point uint16
+
+	// length is the length of the code word, 0 or lookupInvalid.
+	length uint8
+}
+
+// lookupTables are the lookup tables for huffmanMain.
+var lookupTables [len(huffmanMain)][]lookupEntry
+
+func init() {
+	for i, t := range huffmanMain {
+		if t.treelen == 0 {
+			continue
+		}
+		// The tables sharing the tree share the lookup table.
+		if i > 0 && lookupTables[i-1] != nil && &huffmanMain[i-1].hufftable[0] == &t.hufftable[0] {
+			lookupTables[i] = lookupTables[i-1]
+			continue
+		}
+		lookupTables[i] = newLookupTable(t.hufftable, t.treelen)
 	}
-	htptr := huffmanMain[table_num].hufftable
+}
+
+// newLookupTable walks the tree for every combination of lookupBits bits and returns the lookup table.
+func newLookupTable(htptr []uint16, treelen int) []lookupEntry {
+	table := make([]lookupEntry, 1<<lookupBits)
+	for code := range table {
+		point := 0
+		e := lookupEntry{length: lookupInvalid}
+		for n := 0; ; n++ {
+			if (htptr[point] & 0xff00) == 0 {
+				e = lookupEntry{value: htptr[point], length: uint8(n)}
+				break
+			}
+			if n == lookupBits {
+				e = lookupEntry{point: uint16(point)}
+				break
+			}
+			point = next(htptr, point, (code>>(lookupBits-1-n))&1)
+			if point >= treelen {
+				break
+			}
+		}
+		table[code] = e
+	}
+	return table
+}
+
+// next returns the position of the child node in the tree for the bit.
+func next(htptr []uint16, point int, bit int) int {
+	if bit != 0 { // Go right in tree
+		for (htptr[point] & 0xff) >= 250 {
+			point += int(htptr[point]) & 0xff
+		}
+		return point + int(htptr[point])&0xff
+	}
+	// Go left in tree
+	for (htptr[point] >> 8) >= 250 {
+		point += int(htptr[point]) >> 8
+	}
+	return point + int(htptr[point])>>8
+}
+
+// walk reads the code word from the position point in the tree bit by bit.
+func walk(m *bits.Bits, htptr []uint16, treelen int, point int, bitsleft int, table_num int) (uint16, error) {
 	for { // Start reading the Huffman code word,bit by bit
 		// Check if we've matched a code word
 		if (htptr[point] & 0xff00) == 0 {
-			error = 0
-			x = int((htptr[point] >> 4) & 0xf)
-			y = int(htptr[point] & 0xf)
-			break
-		}
-		if m.Bit() != 0 { // Go right in tree
-			for (htptr[point] & 0xff) >= 250 {
-				point += int(htptr[point]) & 0xff
-			}
-			point += int(htptr[point]) & 0xff
-		} else { // Go left in tree
-			for (htptr[point] >> 8) >= 250 {
-				point += int(htptr[point]) >> 8
-			}
-			point += int(htptr[point]) >> 8
+			return htptr[point], nil
 		}
+		point = next(htptr, point, m.Bit())
 		bitsleft--
 		if bitsleft <= 0 || point >= treelen {
 			break
 		}
 	}
-	if error != 0 { // Check for error.
-		err := fmt.Errorf("mp3: illegal Huff code in data. bleft = %d, point = %d. tab = %d.",
-			bitsleft, point, table_num)
+	return 0, fmt.Errorf("%w: illegal Huff code in data. bleft = %d, point = %d. tab = %d.", consts.ErrInvalidMainData,
+		bitsleft, point, table_num)
+}
+
+// decodeValue reads a code word and returns the value in the tree.
+// decodeValue decodes lookupBits bits at once, and walks the tree only for the longer code words.
+func decodeValue(m *bits.Bits, htptr []uint16, treelen int, table_num int) (uint16, error) {
+	switch e := lookupTables[table_num][m.Peek(lookupBits)]; e.length {
+	case 0:
+		m.Skip(lookupBits)
+		return walk(m, htptr, treelen, int(e.point), 32-lookupBits, table_num)
+	case lookupInvalid:
+		// Walk the tree to report the same error as the bit-by-bit decoding.
+		return walk(m, htptr, treelen, 0, 32, table_num)
+	default:
+		m.Skip(int(e.length))
+		return e.value, nil
+	}
+}
+
+func Decode(m *bits.Bits, table_num int) (x, y, v, w int, err error) {
+	if table_num < 0 || table_num >= len(huffmanMain) {
+		return 0, 0, 0, 0, fmt.Errorf("%w: invalid Huffman table: %d", consts.ErrInvalidSideInfo, table_num)
+	}
+	treelen := huffmanMain[table_num].treelen
+	linbits := huffmanMain[table_num].linbits
+	// Tables 0, 4 and 14 are empty. Tables 4 and 14 are not used by the standard, and they are decoded as zero
+	// frequency lines like table 0 rather than an error.
+	if treelen == 0 { // Check for empty tables
+		return 0, 0, 0, 0, nil
+	}
+	htptr := huffmanMain[table_num].hufftable
+
+	value, err := decodeValue(m, htptr, treelen, table_num)
+	if err != nil {
 		return 0, 0, 0, 0, err
 	}
+	x = int((value >> 4) & 0xf)
+	y = int(value & 0xf)
 	if table_num > 31 { // Process sign encodings for quadruples tables.
 		v = (y >> 3) & 1
 		w = (y >> 2) & 1
@@ -0,0 +1,49 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huffman
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/bits"
+)
+
+func TestLookupTables(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 4096)
+	r.Read(data)
+
+	for i, table := range huffmanMain {
+		if table.treelen == 0 {
+			continue
+		}
+		// Compare the lookup with walking the tree bit by bit.
+		m1 := bits.New(data)
+		m2 := bits.New(data)
+		for m1.BitPos() < len(data)*8-32 {
+			got, err1 := decodeValue(m1, table.hufftable, table.treelen, i)
+			want, err2 := walk(m2, table.hufftable, table.treelen, 0, 32, i)
+			if got != want || (err1 == nil) != (err2 == nil) || m1.BitPos() != m2.BitPos() {
+				t.Fatalf("table %d: got: 0x%x, %v at %d, want: 0x%x, %v at %d", i, got, err1, m1.BitPos(), want, err2, m2.BitPos())
+			}
+			if err1 != nil {
+				// Skip the invalid code word.
+				m1.Skip(1)
+				m2.Skip(1)
+			}
+		}
+	}
+}
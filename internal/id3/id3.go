@@ -0,0 +1,524 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package id3 parses ID3v2 tags.
+package id3
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Frame is an ID3v2 frame.
+type Frame struct {
+	ID    string
+	Flags uint16
+	Data  []byte
+}
+
+// Tag is an ID3v2 tag.
+type Tag struct {
+	MajorVersion int
+	Revision     int
+	Flags        byte
+	Frames       []Frame
+}
+
+const (
+	flagUnsynchronisation = 0x80
+	flagExtendedHeader    = 0x40
+
+	// flagCompressionV22 is the ID3v2.2 tag flag for the compression, which no scheme is defined for.
+	flagCompressionV22 = 0x40
+)
+
+// Frame flags in the format flags byte (the second byte) of ID3v2.3.
+const (
+	frameFlagCompressionV23 = 0x0080
+	frameFlagEncryptionV23  = 0x0040
+	frameFlagGroupingV23    = 0x0020
+)
+
+// Frame flags in the format flags byte (the second byte) of ID3v2.4.
+const (
+	frameFlagGroupingV24          = 0x0040
+	frameFlagCompressionV24       = 0x0008
+	frameFlagEncryptionV24        = 0x0004
+	frameFlagUnsynchronisationV24 = 0x0002
+	frameFlagDataLengthV24        = 0x0001
+)
+
+// maxDecompressedSize is the maximum size of a decompressed frame.
+// This protects the parser from decompression bombs.
+const maxDecompressedSize = 16 << 20
+
+func syncsafe(b []byte) int {
+	return int(b[0])<<21 | int(b[1])<<14 | int(b[2])<<7 | int(b[3])
+}
+
+// isSyncsafe reports whether b is a valid syncsafe integer, whose bytes don't have the most significant bit.
+func isSyncsafe(b []byte) bool {
+	return (b[0]|b[1]|b[2]|b[3])&0x80 == 0
+}
+
+// Parse parses an ID3v2 tag including its 10-byte header.
+//
+// The unsynchronisation, the compressed frames and the data length indicators are handled, so the data of the frames
+// is the decoded content. Encrypted frames are omitted as they can't be decoded.
+func Parse(data []byte) (*Tag, error) {
+	if len(data) < 10 || string(data[:3]) != "ID3" {
+		return nil, errors.New("id3: not an ID3v2 tag")
+	}
+	t := &Tag{
+		MajorVersion: int(data[3]),
+		Revision:     int(data[4]),
+		Flags:        data[5],
+	}
+	if t.MajorVersion < 2 || t.MajorVersion > 4 {
+		return nil, fmt.Errorf("id3: unsupported version: 2.%d", t.MajorVersion)
+	}
+	if t.MajorVersion == 2 && t.Flags&flagCompressionV22 != 0 {
+		return nil, errors.New("id3: compressed ID3v2.2 tag is not supported")
+	}
+	size := syncsafe(data[6:10])
+	body := data[10:]
+	if len(body) > size {
+		body = body[:size]
+	}
+
+	// In ID3v2.2 and ID3v2.3, the unsynchronisation is applied to the whole tag.
+	// In ID3v2.4, the unsynchronisation is applied to each frame, and the tag flag means that all the frames are
+	// unsynchronised.
+	unsync := t.Flags&flagUnsynchronisation != 0
+	if unsync && t.MajorVersion < 4 {
+		body = Resynchronise(body)
+	}
+
+	if t.Flags&flagExtendedHeader != 0 && t.MajorVersion >= 3 {
+		if len(body) < 4 {
+			return nil, errors.New("id3: extended header is too short")
+		}
+		var n int
+		if t.MajorVersion == 3 {
+			// The size in ID3v2.3 excludes the size field itself.
+			n = int(binary.BigEndian.Uint32(body)) + 4
+		} else {
+			n = syncsafe(body)
+		}
+		if n < 4 || n > len(body) {
+			return nil, errors.New("id3: invalid extended header size")
+		}
+		body = body[n:]
+	}
+
+	t.Frames = parseFrames(body, t.MajorVersion, unsync && t.MajorVersion == 4)
+	return t, nil
+}
+
+// Resynchronise reverses the unsynchronisation scheme, which inserts a zero byte after every 0xff byte followed by
+// a byte that could be confused with an MPEG frame sync or by a zero byte.
+func Resynchronise(data []byte) []byte {
+	if bytes.Index(data, []byte{0xff, 0x00}) < 0 {
+		return data
+	}
+	r := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		r = append(r, data[i])
+		if data[i] == 0xff && i+1 < len(data) && data[i+1] == 0x00 {
+			i++
+		}
+	}
+	return r
+}
+
+// ParseFrames parses the frames in data for the major version.
+// ParseFrames stops at the padding or at a broken frame.
+func ParseFrames(data []byte, majorVersion int) []Frame {
+	return parseFrames(data, majorVersion, false)
+}
+
+// parseFrames is like ParseFrames. If unsync is true, all the frames are treated as unsynchronised.
+func parseFrames(data []byte, majorVersion int, unsync bool) []Frame {
+	idSize := 4
+	headerSize := 10
+	if majorVersion == 2 {
+		idSize = 3
+		headerSize = 6
+	}
+
+	var frames []Frame
+	for len(data) >= headerSize {
+		if data[0] == 0 {
+			// Padding
+			break
+		}
+		f := Frame{
+			ID: string(data[:idSize]),
+		}
+		var size int
+		switch majorVersion {
+		case 2:
+			size = int(data[3])<<16 | int(data[4])<<8 | int(data[5])
+		case 3:
+			size = int(binary.BigEndian.Uint32(data[4:8]))
+			f.Flags = binary.BigEndian.Uint16(data[8:10])
+		case 4:
+			size = syncsafe(data[4:8])
+			// Some encoders write the frame sizes of ID3v2.4 as plain integers like ID3v2.3.
+			// Use the plain integer when the syncsafe integer is invalid or doesn't reach the next frame.
+			if n := int(binary.BigEndian.Uint32(data[4:8])); n != size {
+				if !isSyncsafe(data[4:8]) || (!atFrame(data[headerSize:], size) && atFrame(data[headerSize:], n)) {
+					size = n
+				}
+			}
+			f.Flags = binary.BigEndian.Uint16(data[8:10])
+		}
+		data = data[headerSize:]
+		if size < 0 || size > len(data) {
+			break
+		}
+		f.Data = data[:size]
+		data = data[size:]
+
+		content, ok := decodeFrameData(f.Data, f.Flags, majorVersion, unsync)
+		if !ok {
+			continue
+		}
+		f.Data = content
+		frames = append(frames, f)
+	}
+	return frames
+}
+
+// atFrame reports whether a frame or the padding starts at pos in data, or pos is the end of data.
+func atFrame(data []byte, pos int) bool {
+	if pos == len(data) {
+		return true
+	}
+	if pos < 0 || pos > len(data) {
+		return false
+	}
+	if data[pos] == 0 {
+		return true
+	}
+	if pos+4 > len(data) {
+		return false
+	}
+	for _, c := range data[pos : pos+4] {
+		if !('A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeFrameData decodes the data of a frame with the flags.
+// decodeFrameData returns false if the data can't be decoded, e.g. when the frame is encrypted.
+func decodeFrameData(data []byte, flags uint16, majorVersion int, unsync bool) ([]byte, bool) {
+	switch majorVersion {
+	case 3:
+		// The additional data follows the frame header in the order of the flags.
+		var decompressedSize int
+		if flags&frameFlagCompressionV23 != 0 {
+			if len(data) < 4 {
+				return nil, false
+			}
+			decompressedSize = int(binary.BigEndian.Uint32(data))
+			data = data[4:]
+		}
+		if flags&frameFlagEncryptionV23 != 0 {
+			return nil, false
+		}
+		if flags&frameFlagGroupingV23 != 0 {
+			if len(data) < 1 {
+				return nil, false
+			}
+			data = data[1:]
+		}
+		if flags&frameFlagCompressionV23 != 0 {
+			return decompress(data, decompressedSize)
+		}
+		return data, true
+
+	case 4:
+		if flags&frameFlagGroupingV24 != 0 {
+			if len(data) < 1 {
+				return nil, false
+			}
+			data = data[1:]
+		}
+		if flags&frameFlagEncryptionV24 != 0 {
+			return nil, false
+		}
+		decodedSize := -1
+		if flags&frameFlagDataLengthV24 != 0 {
+			if len(data) < 4 {
+				return nil, false
+			}
+			decodedSize = syncsafe(data)
+			data = data[4:]
+		}
+		if unsync || flags&frameFlagUnsynchronisationV24 != 0 {
+			data = Resynchronise(data)
+		}
+		if flags&frameFlagCompressionV24 != 0 {
+			if decodedSize < 0 {
+				// The data length indicator is mandatory for compressed frames, but be tolerant.
+				decodedSize = maxDecompressedSize
+			}
+			return decompress(data, decodedSize)
+		}
+		return data, true
+	}
+	return data, true
+}
+
+// decompress decompresses zlib-compressed data of the given decompressed size.
+func decompress(data []byte, size int) ([]byte, bool) {
+	if size < 0 || size > maxDecompressedSize {
+		return nil, false
+	}
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(r, int64(size)))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// Comment is a COMM frame.
+type Comment struct {
+	// Language is the ISO-639-2 language code like "eng".
+	Language    string
+	Description string
+	Text        string
+}
+
+// ParseComment parses the content of a COMM frame.
+// ParseComment can also parse a USLT frame, which has the same structure.
+func ParseComment(data []byte) (*Comment, error) {
+	if len(data) < 4 {
+		return nil, errors.New("id3: COMM frame is too short")
+	}
+	enc := data[0]
+	desc, text := splitText(enc, data[4:])
+	return &Comment{
+		Language:    latin1(data[1:4]),
+		Description: decodeText(enc, desc),
+		Text:        decodeText(enc, text),
+	}, nil
+}
+
+// SyncedText is a SYLT frame.
+type SyncedText struct {
+	// Language is the ISO-639-2 language code like "eng".
+	Language string
+
+	// TimestampFormat is 1 for MPEG frames or 2 for milliseconds.
+	TimestampFormat int
+
+	// ContentType is the type of the content like 1 for lyrics.
+	ContentType int
+
+	Description string
+	Entries     []SyncedTextEntry
+}
+
+// SyncedTextEntry is an entry of a SYLT frame.
+type SyncedTextEntry struct {
+	Text      string
+	Timestamp uint32
+}
+
+// ParseSyncedText parses the content of a SYLT frame.
+func ParseSyncedText(data []byte) (*SyncedText, error) {
+	if len(data) < 6 {
+		return nil, errors.New("id3: SYLT frame is too short")
+	}
+	enc := data[0]
+	s := &SyncedText{
+		Language:        latin1(data[1:4]),
+		TimestampFormat: int(data[4]),
+		ContentType:     int(data[5]),
+	}
+	desc, data := splitText(enc, data[6:])
+	s.Description = decodeText(enc, desc)
+	for len(data) > 0 {
+		var text []byte
+		text, data = splitText(enc, data)
+		if len(data) < 4 {
+			return nil, errors.New("id3: SYLT frame is too short")
+		}
+		s.Entries = append(s.Entries, SyncedTextEntry{
+			Text:      decodeText(enc, text),
+			Timestamp: binary.BigEndian.Uint32(data),
+		})
+		data = data[4:]
+	}
+	return s, nil
+}
+
+// UserText is a TXXX frame.
+type UserText struct {
+	Description string
+	Value       string
+}
+
+// ParseUserText parses the content of a TXXX frame.
+func ParseUserText(data []byte) (*UserText, error) {
+	if len(data) < 1 {
+		return nil, errors.New("id3: TXXX frame is too short")
+	}
+	enc := data[0]
+	desc, value := splitText(enc, data[1:])
+	return &UserText{
+		Description: decodeText(enc, desc),
+		Value:       decodeText(enc, value),
+	}, nil
+}
+
+// frameIDsV22 maps the 3-character frame IDs of ID3v2.2 to the frame IDs of ID3v2.3 and later.
+var frameIDsV22 = map[string]string{
+	"COM": "COMM",
+	"SLT": "SYLT",
+	"TAL": "TALB",
+	"TCM": "TCOM",
+	"TCO": "TCON",
+	"TP1": "TPE1",
+	"TP2": "TPE2",
+	"TPA": "TPOS",
+	"TRK": "TRCK",
+	"TT2": "TIT2",
+	"TXX": "TXXX",
+	"TYE": "TYER",
+	"UFI": "UFID",
+	"ULT": "USLT",
+}
+
+// CanonicalID returns the ID3v2.3 or later frame ID for the frame ID of the major version.
+// CanonicalID returns id as it is if there is no counterpart.
+func CanonicalID(id string, majorVersion int) string {
+	if majorVersion != 2 {
+		return id
+	}
+	if c, ok := frameIDsV22[id]; ok {
+		return c
+	}
+	return id
+}
+
+// Chapter is a CHAP frame.
+type Chapter struct {
+	ElementID string
+
+	// StartTime and EndTime are in milliseconds.
+	StartTime uint32
+	EndTime   uint32
+
+	// StartOffset and EndOffset are byte offsets. 0xffffffff means the offsets are not used.
+	StartOffset uint32
+	EndOffset   uint32
+
+	// Frames are the embedded frames like TIT2.
+	Frames []Frame
+}
+
+// ParseChapter parses the content of a CHAP frame.
+func ParseChapter(data []byte, majorVersion int) (*Chapter, error) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 || len(data) < i+1+16 {
+		return nil, errors.New("id3: CHAP frame is too short")
+	}
+	c := &Chapter{
+		ElementID: latin1(data[:i]),
+	}
+	data = data[i+1:]
+	c.StartTime = binary.BigEndian.Uint32(data[0:4])
+	c.EndTime = binary.BigEndian.Uint32(data[4:8])
+	c.StartOffset = binary.BigEndian.Uint32(data[8:12])
+	c.EndOffset = binary.BigEndian.Uint32(data[12:16])
+	c.Frames = ParseFrames(data[16:], majorVersion)
+	return c, nil
+}
+
+// TableOfContents is a CTOC frame.
+type TableOfContents struct {
+	ElementID string
+
+	// TopLevel reports whether the table of contents is the root of the hierarchy.
+	TopLevel bool
+
+	// Ordered reports whether the child elements are ordered.
+	Ordered bool
+
+	// ChildIDs are the element IDs of the child CHAP or CTOC frames.
+	ChildIDs []string
+
+	// Frames are the embedded frames like TIT2.
+	Frames []Frame
+}
+
+const (
+	ctocFlagOrdered  = 0x01
+	ctocFlagTopLevel = 0x02
+)
+
+// ParseTableOfContents parses the content of a CTOC frame.
+func ParseTableOfContents(data []byte, majorVersion int) (*TableOfContents, error) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 || len(data) < i+1+2 {
+		return nil, errors.New("id3: CTOC frame is too short")
+	}
+	t := &TableOfContents{
+		ElementID: latin1(data[:i]),
+		TopLevel:  data[i+1]&ctocFlagTopLevel != 0,
+		Ordered:   data[i+1]&ctocFlagOrdered != 0,
+	}
+	n := int(data[i+2])
+	data = data[i+3:]
+	for j := 0; j < n; j++ {
+		k := bytes.IndexByte(data, 0)
+		if k < 0 {
+			return nil, errors.New("id3: CTOC frame is too short")
+		}
+		t.ChildIDs = append(t.ChildIDs, latin1(data[:k]))
+		data = data[k+1:]
+	}
+	t.Frames = ParseFrames(data, majorVersion)
+	return t, nil
+}
+
+// URL decodes the content of a URL link frame like WOAR, or of a WXXX frame, which has a description before the
+// URL.
+func URL(id string, data []byte) string {
+	if id == "WXXX" || id == "WXX" {
+		if len(data) == 0 {
+			return ""
+		}
+		_, data = splitText(data[0], data[1:])
+	}
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return latin1(data)
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package id3
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Text encodings of ID3v2 frames.
+const (
+	encodingISO88591 = 0
+	encodingUTF16    = 1 // UTF-16 with a BOM
+	encodingUTF16BE  = 2 // UTF-16BE without a BOM (ID3v2.4)
+	encodingUTF8     = 3 // ID3v2.4
+)
+
+// Text decodes the content of a text frame like TIT2.
+// Multiple values separated by the terminators, which ID3v2.4 allows, are joined with "/".
+func Text(data []byte) string {
+	return strings.Join(TextValues(data), "/")
+}
+
+// TextValues decodes the content of a text frame like TIT2 into its values.
+// ID3v2.4 allows multiple values separated by the terminators.
+func TextValues(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	enc := data[0]
+	data = data[1:]
+	var values []string
+	for len(data) > 0 {
+		var v []byte
+		v, data = splitText(enc, data)
+		values = append(values, decodeText(enc, v))
+	}
+	// Ignore the empty values like the trailing terminator.
+	r := values[:0]
+	for _, v := range values {
+		if v != "" {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// decodeText decodes data in the text encoding enc to a UTF-8 string.
+// The trailing terminators are ignored.
+func decodeText(enc byte, data []byte) string {
+	switch enc {
+	case encodingUTF16:
+		return decodeUTF16(data, false)
+	case encodingUTF16BE:
+		return decodeUTF16(data, true)
+	case encodingUTF8:
+		data = bytes.TrimRight(data, "\x00")
+		// Some encoders write ISO-8859-1 text with the UTF-8 encoding.
+		if !utf8.Valid(data) {
+			return latin1(data)
+		}
+		return string(data)
+	default:
+		return latin1(bytes.TrimRight(data, "\x00"))
+	}
+}
+
+// decodeUTF16 decodes UTF-16 data to a UTF-8 string.
+//
+// A BOM determines the byte order. Without a BOM, big endian is assumed if bigEndian is true. Otherwise, the byte order
+// is guessed from the positions of the zero bytes as the BOM is required by the spec but some encoders omit it.
+func decodeUTF16(data []byte, bigEndian bool) string {
+	switch {
+	case len(data) >= 2 && data[0] == 0xfe && data[1] == 0xff:
+		bigEndian = true
+		data = data[2:]
+	case len(data) >= 2 && data[0] == 0xff && data[1] == 0xfe:
+		bigEndian = false
+		data = data[2:]
+	case !bigEndian:
+		bigEndian = guessUTF16BigEndian(data)
+	}
+
+	u := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		if bigEndian {
+			u = append(u, uint16(data[i])<<8|uint16(data[i+1]))
+		} else {
+			u = append(u, uint16(data[i])|uint16(data[i+1])<<8)
+		}
+	}
+	for len(u) > 0 && u[len(u)-1] == 0 {
+		u = u[:len(u)-1]
+	}
+	return string(utf16.Decode(u))
+}
+
+// guessUTF16BigEndian reports whether UTF-16 data without a BOM looks big endian.
+// Text in Latin scripts has zero bytes at the even positions in big endian.
+func guessUTF16BigEndian(data []byte) bool {
+	var even, odd int
+	for i := 0; i+1 < len(data); i += 2 {
+		if data[i] == 0 {
+			even++
+		}
+		if data[i+1] == 0 {
+			odd++
+		}
+	}
+	return even >= odd
+}
+
+// splitText splits data in the text encoding enc at the first terminator.
+// If there is no terminator, splitText returns data and nil.
+func splitText(enc byte, data []byte) (text []byte, rest []byte) {
+	if enc == encodingUTF16 || enc == encodingUTF16BE {
+		// UTF-16 is terminated by two zero bytes at an even position.
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return data[:i], data[i+2:]
+			}
+		}
+		return data, nil
+	}
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return data, nil
+	}
+	return data[:i], data[i+1:]
+}
+
+// latin1 decodes ISO-8859-1 data to a UTF-8 string.
+func latin1(data []byte) string {
+	rs := make([]rune, len(data))
+	for i, b := range data {
+		rs[i] = rune(b)
+	}
+	return string(rs)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package id3
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// V1Size is the size of an ID3v1 tag.
+const V1Size = 128
+
+// V1 is an ID3v1 or ID3v1.1 tag.
+type V1 struct {
+	Title   string
+	Artist  string
+	Album   string
+	Year    int
+	Comment string
+
+	// Track is the track number in ID3v1.1, or 0 in ID3v1.
+	Track int
+
+	// Genre is the genre index. 255 means no genre.
+	Genre int
+}
+
+// ParseV1 parses a 128-byte ID3v1 tag.
+func ParseV1(data []byte) (*V1, error) {
+	if len(data) != V1Size || string(data[:3]) != "TAG" {
+		return nil, errors.New("id3: not an ID3v1 tag")
+	}
+	t := &V1{
+		Title:  v1String(data[3:33]),
+		Artist: v1String(data[33:63]),
+		Album:  v1String(data[63:93]),
+		Genre:  int(data[127]),
+	}
+	if y, err := strconv.Atoi(v1String(data[93:97])); err == nil {
+		t.Year = y
+	}
+	comment := data[97:127]
+	// In ID3v1.1, the last 2 bytes of the comment are a zero byte and the track number.
+	if comment[28] == 0 && comment[29] != 0 {
+		t.Track = int(comment[29])
+		comment = comment[:28]
+	}
+	t.Comment = v1String(comment)
+	return t, nil
+}
+
+// v1String decodes a field of an ID3v1 tag, which is padded with zero bytes or spaces.
+func v1String(data []byte) string {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return latin1(bytes.TrimRight(data, " "))
+}
+
+// GenreName returns the name of the ID3v1 genre index including the Winamp extensions.
+// GenreName returns an empty string if the index is unknown.
+func GenreName(index int) string {
+	if index < 0 || index >= len(genres) {
+		return ""
+	}
+	return genres[index]
+}
+
+// GenreNames returns the human-readable genres of the values of a TCON frame.
+//
+// A value can be an ID3v1 genre index like "17", which ID3v2.4 uses, or references to the indices in parentheses
+// followed by an optional refinement like "(17)(18)Eurorock", which ID3v2.3 and older use. "RX" and "CR" mean Remix
+// and Cover. Other values are returned as they are.
+func GenreNames(values []string) []string {
+	var names []string
+	add := func(name string) {
+		for _, n := range names {
+			if n == name {
+				return
+			}
+		}
+		names = append(names, name)
+	}
+	for _, v := range values {
+		if name, ok := genreRef(v); ok {
+			add(name)
+			continue
+		}
+		for strings.HasPrefix(v, "(") && !strings.HasPrefix(v, "((") {
+			i := strings.IndexByte(v, ')')
+			if i < 0 {
+				break
+			}
+			name, ok := genreRef(v[1:i])
+			if !ok {
+				break
+			}
+			add(name)
+			v = v[i+1:]
+		}
+		// "((" escapes a refinement starting with "(".
+		if strings.HasPrefix(v, "((") {
+			v = v[1:]
+		}
+		if v != "" {
+			add(v)
+		}
+	}
+	return names
+}
+
+// genreRef returns the genre name of a reference like "17" or "RX".
+func genreRef(ref string) (string, bool) {
+	switch ref {
+	case "RX":
+		return "Remix", true
+	case "CR":
+		return "Cover", true
+	}
+	if ref == "" || strings.TrimLeft(ref, "0123456789") != "" {
+		return "", false
+	}
+	n, err := strconv.Atoi(ref)
+	if err != nil {
+		return "", false
+	}
+	name := GenreName(n)
+	return name, name != ""
+}
+
+var genres = [...]string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap",
+	"Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks",
+	"Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock",
+	"Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap", "Pop/Funk", "Jungle",
+	"Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes", "Trailer", "Lo-Fi",
+	"Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+
+	// Winamp extensions
+	"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebob", "Latin", "Revival",
+	"Celtic", "Bluegrass", "Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock", "Symphonic Rock", "Slow Rock",
+	"Big Band", "Chorus", "Easy Listening", "Acoustic", "Humour", "Speech", "Chanson", "Opera",
+	"Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove", "Satire", "Slow Jam",
+	"Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+	"Duet", "Punk Rock", "Drum Solo", "A capella", "Euro-House", "Dance Hall", "Goa", "Drum & Bass",
+	"Club-House", "Hardcore Techno", "Terror", "Indie", "BritPop", "Negerpunk", "Polsk Punk", "Beat",
+	"Christian Gangsta Rap", "Heavy Metal", "Black Metal", "Crossover", "Contemporary Christian", "Christian Rock", "Merengue", "Salsa",
+	"Thrash Metal", "Anime", "Jpop", "Synthpop", "Abstract", "Art Rock", "Baroque", "Bhangra",
+	"Big Beat", "Breakbeat", "Chillout", "Downtempo", "Dub", "EBM", "Eclectic", "Electro",
+	"Electroclash", "Emo", "Experimental", "Garage", "Global", "IDM", "Illbient", "Industro-Goth",
+	"Jam Band", "Krautrock", "Leftfield", "Lounge", "Math Rock", "New Romantic", "Nu-Breakz", "Post-Punk",
+	"Post-Rock", "Psytrance", "Shoegaze", "Space Rock", "Trop Rock", "World Music", "Neoclassical", "Audiobook",
+	"Audio Theatre", "Neue Deutsche Welle", "Podcast", "Indie Rock", "G-Funk", "Dubstep", "Garage Rock", "Psybient",
+}
@@ -12,57 +12,27 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package imdct
+//go:build !mp3fixed
+// +build !mp3fixed
 
-import (
-	"math"
-)
+package imdct
 
 var imdctWinData = [4][36]float32{}
 
 func init() {
-	for i := 0; i < 36; i++ {
-		imdctWinData[0][i] = float32(math.Sin(math.Pi / 36 * (float64(i) + 0.5)))
-	}
-	for i := 0; i < 18; i++ {
-		imdctWinData[1][i] = float32(math.Sin(math.Pi / 36 * (float64(i) + 0.5)))
-	}
-	for i := 18; i < 24; i++ {
-		imdctWinData[1][i] = 1.0
-	}
-	for i := 24; i < 30; i++ {
-		imdctWinData[1][i] = float32(math.Sin(math.Pi / 12 * (float64(i) + 0.5 - 18.0)))
-	}
-	for i := 30; i < 36; i++ {
-		imdctWinData[1][i] = 0.0
-	}
-	for i := 0; i < 12; i++ {
-		imdctWinData[2][i] = float32(math.Sin(math.Pi / 12 * (float64(i) + 0.5)))
-	}
-	for i := 12; i < 36; i++ {
-		imdctWinData[2][i] = 0.0
-	}
-	for i := 0; i < 6; i++ {
-		imdctWinData[3][i] = 0.0
-	}
-	for i := 6; i < 12; i++ {
-		imdctWinData[3][i] = float32(math.Sin(math.Pi / 12 * (float64(i) + 0.5 - 6.0)))
-	}
-	for i := 12; i < 18; i++ {
-		imdctWinData[3][i] = 1.0
-	}
-	for i := 18; i < 36; i++ {
-		imdctWinData[3][i] = float32(math.Sin(math.Pi / 36 * (float64(i) + 0.5)))
+	for b := 0; b < 4; b++ {
+		for i := 0; i < 36; i++ {
+			imdctWinData[b][i] = float32(window(b, i))
+		}
 	}
 }
 
 var cosN12 = [6][12]float32{}
 
 func init() {
-	const N = 12
 	for i := 0; i < 6; i++ {
 		for j := 0; j < 12; j++ {
-			cosN12[i][j] = float32(math.Cos(math.Pi / (2 * N) * (2*float64(j) + 1 + N/2) * (2*float64(i) + 1)))
+			cosN12[i][j] = float32(cosN(12, i, j))
 		}
 	}
 }
@@ -70,38 +40,42 @@ func init() {
 var cosN36 = [18][36]float32{}
 
 func init() {
-	const N = 36
 	for i := 0; i < 18; i++ {
 		for j := 0; j < 36; j++ {
-			cosN36[i][j] = float32(math.Cos(math.Pi / (2 * N) * (2*float64(j) + 1 + N/2) * (2*float64(i) + 1)))
+			cosN36[i][j] = float32(cosN(36, i, j))
 		}
 	}
 }
 
-func Win(in []float32, blockType int) []float32 {
-	out := make([]float32, 36)
+// Win writes the inverse MDCT of in windowed for blockType to out. in and out must have 18 and 36 elements.
+//
+// The products are rounded to float32 explicitly so that they are not fused into multiply-add instructions, which
+// would make the output differ among platforms.
+func Win(out []float32, in []float32, blockType int) {
 	if blockType == 2 {
+		for i := range out {
+			out[i] = 0
+		}
 		iwd := imdctWinData[blockType]
 		const N = 12
 		for i := 0; i < 3; i++ {
 			for p := 0; p < N; p++ {
 				sum := float32(0.0)
 				for m := 0; m < N/2; m++ {
-					sum += in[i+3*m] * cosN12[m][p]
+					sum += float32(in[i+3*m] * cosN12[m][p])
 				}
-				out[6*i+p+6] += sum * iwd[p]
+				out[6*i+p+6] += float32(sum * iwd[p])
 			}
 		}
-		return out
+		return
 	}
 	const N = 36
 	iwd := imdctWinData[blockType]
 	for p := 0; p < N; p++ {
 		sum := float32(0.0)
 		for m := 0; m < N/2; m++ {
-			sum += in[m] * cosN36[m][p]
+			sum += float32(in[m] * cosN36[m][p])
 		}
 		out[p] = sum * iwd[p]
 	}
-	return out
 }
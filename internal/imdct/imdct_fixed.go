@@ -0,0 +1,76 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build mp3fixed
+// +build mp3fixed
+
+package imdct
+
+import (
+	"github.com/hajimehoshi/go-mp3/internal/fixed"
+)
+
+var (
+	imdctWinFixed [4][36]int32
+	cosN12Fixed   [6][12]int32
+	cosN36Fixed   [18][36]int32
+)
+
+func init() {
+	for b := 0; b < 4; b++ {
+		for i := 0; i < 36; i++ {
+			imdctWinFixed[b][i] = fixed.FromFloat(window(b, i))
+		}
+	}
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 12; j++ {
+			cosN12Fixed[i][j] = fixed.FromFloat(cosN(12, i, j))
+		}
+	}
+	for i := 0; i < 18; i++ {
+		for j := 0; j < 36; j++ {
+			cosN36Fixed[i][j] = fixed.FromFloat(cosN(36, i, j))
+		}
+	}
+}
+
+// WinFixed is the fixed-point version of Win. in and out are Q28 numbers and must have 18 and 36 elements.
+func WinFixed(out []int32, in []int32, blockType int) {
+	if blockType == 2 {
+		for i := range out {
+			out[i] = 0
+		}
+		iwd := &imdctWinFixed[blockType]
+		const N = 12
+		for i := 0; i < 3; i++ {
+			for p := 0; p < N; p++ {
+				sum := int64(0)
+				for m := 0; m < N/2; m++ {
+					sum += int64(in[i+3*m]) * int64(cosN12Fixed[m][p]) >> fixed.FracBits
+				}
+				out[6*i+p+6] = fixed.Saturate(int64(out[6*i+p+6]) + int64(fixed.Mul(fixed.Saturate(sum), iwd[p])))
+			}
+		}
+		return
+	}
+	const N = 36
+	iwd := &imdctWinFixed[blockType]
+	for p := 0; p < N; p++ {
+		sum := int64(0)
+		for m := 0; m < N/2; m++ {
+			sum += int64(in[m]) * int64(cosN36Fixed[m][p]) >> fixed.FracBits
+		}
+		out[p] = fixed.Mul(fixed.Saturate(sum), iwd[p])
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build mp3fixed
+// +build mp3fixed
+
+package imdct
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/fixed"
+)
+
+func TestWinFixed(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for blockType := 0; blockType < 4; blockType++ {
+		var in [18]int32
+		var infl [18]float64
+		for i := range in {
+			in[i] = fixed.FromFloat(r.Float64()*2 - 1)
+			infl[i] = fixed.ToFloat(in[i])
+		}
+		var out [36]int32
+		WinFixed(out[:], in[:], blockType)
+
+		var want [36]float64
+		if blockType == 2 {
+			for i := 0; i < 3; i++ {
+				for p := 0; p < 12; p++ {
+					sum := 0.0
+					for m := 0; m < 6; m++ {
+						sum += infl[i+3*m] * cosN(12, m, p)
+					}
+					want[6*i+p+6] += sum * window(blockType, p)
+				}
+			}
+		} else {
+			for p := 0; p < 36; p++ {
+				sum := 0.0
+				for m := 0; m < 18; m++ {
+					sum += infl[m] * cosN(36, m, p)
+				}
+				want[p] = sum * window(blockType, p)
+			}
+		}
+		for i := range out {
+			if d := math.Abs(fixed.ToFloat(out[i]) - want[i]); d > 1e-7 {
+				t.Errorf("block type %d, out[%d]: got: %v, want: %v", blockType, i, fixed.ToFloat(out[i]), want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imdct
+
+import (
+	"math"
+)
+
+// window returns the i-th coefficient of the window for blockType.
+func window(blockType int, i int) float64 {
+	switch blockType {
+	case 0:
+		return math.Sin(math.Pi / 36 * (float64(i) + 0.5))
+	case 1:
+		switch {
+		case i < 18:
+			return math.Sin(math.Pi / 36 * (float64(i) + 0.5))
+		case i < 24:
+			return 1.0
+		case i < 30:
+			return math.Sin(math.Pi / 12 * (float64(i) + 0.5 - 18.0))
+		}
+		return 0.0
+	case 2:
+		if i < 12 {
+			return math.Sin(math.Pi / 12 * (float64(i) + 0.5))
+		}
+		return 0.0
+	case 3:
+		switch {
+		case i < 6:
+			return 0.0
+		case i < 12:
+			return math.Sin(math.Pi / 12 * (float64(i) + 0.5 - 6.0))
+		case i < 18:
+			return 1.0
+		}
+		return math.Sin(math.Pi / 36 * (float64(i) + 0.5))
+	}
+	panic("not reached")
+}
+
+// cosN returns the coefficient of the inverse MDCT of N outputs for the i-th input and the j-th output.
+func cosN(N int, i, j int) float64 {
+	n := float64(N)
+	return math.Cos(math.Pi / (2 * n) * (2*float64(j) + 1 + n/2) * (2*float64(i) + 1))
+}
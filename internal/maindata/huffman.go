@@ -24,7 +24,7 @@ import (
 	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
 )
 
-func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo, mainData *MainData, part_2_start, gr, ch int) error {
+func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo, mainData *MainData, part_2_start, gr, ch, maxLines int) error {
 	// Check that there is any data to decode. If not, zero the array.
 	if sideInfo.Part2_3Length[gr][ch] == 0 {
 		for i := 0; i < consts.SamplesPerGr; i++ {
@@ -39,22 +39,21 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 	region_1_start := 0
 	region_2_start := 0
 	if (sideInfo.WinSwitchFlag[gr][ch] == 1) && (sideInfo.BlockType[gr][ch] == 2) {
-		region_1_start = 36                  // sfb[9/3]*3=36
+		_, s := header.SfBandIndices()
+		region_1_start = s[3] * 3            // sfb[9/3]*3=36 except for MPEG-2.5 8kHz
 		region_2_start = consts.SamplesPerGr // No Region2 for short block case.
 	} else {
-		sfreq := header.SamplingFrequency()
-		lsf := header.LowSamplingFrequency()
-		l := consts.SfBandIndices[lsf][sfreq][consts.SfBandIndicesLong]
+		l, _ := header.SfBandIndices()
 		i := sideInfo.Region0Count[gr][ch] + 1
 		if i < 0 || len(l) <= i {
 			// TODO: Better error messages (#3)
-			return fmt.Errorf("mp3: readHuffman failed: invalid index i: %d", i)
+			return fmt.Errorf("%w: readHuffman failed: invalid index i: %d", consts.ErrInvalidSideInfo, i)
 		}
 		region_1_start = l[i]
 		j := sideInfo.Region0Count[gr][ch] + sideInfo.Region1Count[gr][ch] + 2
 		if j < 0 || len(l) <= j {
 			// TODO: Better error messages (#3)
-			return fmt.Errorf("mp3: readHuffman failed: invalid index j: %d", j)
+			return fmt.Errorf("%w: readHuffman failed: invalid index j: %d", consts.ErrInvalidSideInfo, j)
 		}
 		region_2_start = l[j]
 	}
@@ -62,7 +61,11 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 	for is_pos := 0; is_pos < sideInfo.BigValues[gr][ch]*2; is_pos++ {
 		// #22
 		if is_pos >= len(mainData.Is[gr][ch]) {
-			return fmt.Errorf("mp3: is_pos was too big: %d", is_pos)
+			return fmt.Errorf("%w: is_pos was too big: %d", consts.ErrInvalidMainData, is_pos)
+		}
+		if is_pos >= maxLines {
+			// Skip the rest.
+			return zeroFrom(m, sideInfo, mainData, gr, ch, is_pos, bit_pos_end)
 		}
 		table_num := 0
 		if is_pos < region_1_start {
@@ -77,6 +80,10 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 		if err != nil {
 			return err
 		}
+		if m.Overrun() {
+			// The rest of the main data is missing in a truncated frame.
+			return zeroFrom(m, sideInfo, mainData, gr, ch, is_pos, bit_pos_end)
+		}
 		// In the big_values area there are two freq lines per Huffman word
 		mainData.Is[gr][ch][is_pos] = float32(x)
 		is_pos++
@@ -86,7 +93,7 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 	// TODO: Is this comment wrong?
 	table_num := sideInfo.Count1TableSelect[gr][ch] + 32
 	is_pos := sideInfo.BigValues[gr][ch] * 2
-	for is_pos <= 572 && m.BitPos() <= bit_pos_end {
+	for is_pos <= 572 && is_pos < maxLines && m.BitPos() <= bit_pos_end && !m.Overrun() {
 		// Get next Huffman coded words
 		x, y, v, w, err := huffman.Decode(m, table_num)
 		if err != nil {
@@ -111,7 +118,7 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 		is_pos++
 	}
 	// Check that we didn't read past the end of this section
-	if m.BitPos() > (bit_pos_end + 1) {
+	if m.BitPos() > (bit_pos_end+1) || m.Overrun() {
 		// Remove last words read
 		is_pos -= 4
 	}
@@ -119,6 +126,11 @@ func readHuffman(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinf
 		is_pos = 0
 	}
 
+	return zeroFrom(m, sideInfo, mainData, gr, ch, is_pos, bit_pos_end)
+}
+
+// zeroFrom zeroes the frequency lines from is_pos and moves m to the next part.
+func zeroFrom(m *bits.Bits, sideInfo *sideinfo.SideInfo, mainData *MainData, gr, ch, is_pos, bit_pos_end int) error {
 	// Setup count1 which is the index of the first sample in the rzero reg.
 	sideInfo.Count1[gr][ch] = is_pos
 
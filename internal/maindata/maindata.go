@@ -33,6 +33,16 @@ type MainData struct {
 	ScalefacL [2][2][22]int      // 0-4 bits
 	ScalefacS [2][2][13][3]int   // 0-4 bits
 	Is        [2][2][576]float32 // Huffman coded freq. lines
+
+	// IllegalPosL and IllegalPosS report whether the scale factors of the right channel are at the illegal
+	// intensity stereo position, which is the maximum value for their bit lengths. This is used only for MPEG-2
+	// and MPEG-2.5 intensity stereo.
+	IllegalPosL [22]bool
+	IllegalPosS [13][3]bool
+
+	// Truncated reports whether the main data is cut at the end of the stream. The missing frequency lines are
+	// decoded as zero.
+	Truncated bool
 }
 
 var scalefacSizesMpeg1 = [16][2]int{
@@ -50,6 +60,34 @@ var scalefacSizesMpeg2 = [3][6][4]int{
 
 var nSlen2 = initSlen() /* MPEG 2.0 slen for 'normal' mode */
 
+var iSlen2 = initISlen() /* MPEG 2.0 slen for intensity stereo of the right channel */
+
+func initISlen() (iSlen2 [256]int) {
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 6; j++ {
+			for k := 0; k < 6; k++ {
+				n := k + j*6 + i*36
+				iSlen2[n] = i | (j << 3) | (k << 6) | (3 << 12)
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				n := k + j*4 + i*16
+				iSlen2[n+180] = i | (j << 3) | (k << 6) | (4 << 12)
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 3; j++ {
+			n := j + i*3
+			iSlen2[n+244] = i | (j << 3) | (5 << 12)
+		}
+	}
+	return
+}
+
 func initSlen() (nSlen2 [512]int) {
 	for i := 0; i < 4; i++ {
 		for j := 0; j < 3; j++ {
@@ -79,15 +117,28 @@ func initSlen() (nSlen2 [512]int) {
 	return
 }
 
-func Read(source FullReader, prev *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo) (*MainData, *bits.Bits, error) {
+// Read reads the main data of a frame.
+//
+// If partial is true, the main data cut at the end of source is decoded as far as available instead of returning
+// an error.
+//
+// maxLines is the number of the frequency lines to decode in each granule. The higher lines are decoded as zero,
+// and their Huffman codes are skipped.
+//
+// r is the bit reservoir, and prev is the main data of the previous frame read to r, or nil. The result is read to
+// r without changing prev.
+//
+// If md is not nil, md is cleared and reused for the result instead of allocating a new MainData.
+func Read(source FullReader, r *Reservoir, prev *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo, partial bool, maxLines int, md *MainData) (*MainData, *bits.Bits, error) {
 	nch := header.NumberOfChannels()
 	// Calculate header audio data size
 	framesize, err := header.FrameSize()
 	if err != nil {
 		return nil, nil, err
 	}
-	if framesize > 2000 {
-		return nil, nil, fmt.Errorf("mp3: framesize = %d", framesize)
+	// frameheader.Read doesn't accept such headers.
+	if framesize > frameheader.MaxFrameSize {
+		return nil, nil, fmt.Errorf("%w: framesize = %d", consts.ErrInvalidHeader, framesize)
 	}
 	sideinfo_size := header.SideInfoSize()
 
@@ -101,28 +152,63 @@ func Read(source FullReader, prev *bits.Bits, header frameheader.FrameHeader, si
 	// two frames. main_data_begin indicates how many bytes from previous
 	// frames that should be used. This buffer is later accessed by the
 	// Bits function in the same way as the side info is.
-	m, err := read(source, prev, main_data_size, sideInfo.MainDataBegin)
+	m, truncated, err := read(source, r, prev, main_data_size, sideInfo.MainDataBegin, partial)
 	if err != nil {
-		// This could be due to not enough data in reservoir
 		return nil, nil, err
 	}
+	if md == nil {
+		md = &MainData{}
+	} else {
+		*md = MainData{}
+	}
+	if underflow(prev, sideInfo.MainDataBegin) {
+		// The main data of this frame is lost. Decode the frame as silence, and keep the main data of this frame
+		// as the bit reservoir for the next frames.
+		return md, m, nil
+	}
 
 	if header.LowSamplingFrequency() == 1 {
-		return getScaleFactorsMpeg2(m, header, sideInfo)
+		m, err = getScaleFactorsMpeg2(md, m, header, sideInfo, maxLines)
+	} else {
+		m, err = getScaleFactorsMpeg1(md, nch, m, header, sideInfo, maxLines)
+	}
+	if err != nil {
+		return nil, nil, err
 	}
-	return getScaleFactorsMpeg1(nch, m, header, sideInfo)
+	md.Truncated = truncated
+	return md, m, nil
 }
 
-func getScaleFactorsMpeg2(m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo) (*MainData, *bits.Bits, error) {
+// scalefacCompressError returns an error for scalefac_compress out of the range of the scale factor length tables.
+// sideinfo.Read rejects such values, but the tables must not be indexed by an unchecked value.
+func scalefacCompressError(gr, ch, value int) error {
+	return &sideinfo.ValueError{
+		Field:   "scalefac_compress",
+		Granule: gr,
+		Channel: ch,
+		Value:   value,
+	}
+}
 
-	nch := header.NumberOfChannels()
+func getScaleFactorsMpeg2(md *MainData, m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo, maxLines int) (*bits.Bits, error) {
 
-	md := &MainData{}
+	nch := header.NumberOfChannels()
 
 	for ch := 0; ch < nch; ch++ {
 		part_2_start := m.BitPos()
 		numbits := 0
-		slen := nSlen2[sideInfo.ScalefacCompress[0][ch]]
+		// The scale factors of the right channel are intensity stereo positions with intensity stereo.
+		intensity := ch == 1 && header.UseIntensityStereo()
+		sfc := sideInfo.ScalefacCompress[0][ch]
+		if sfc < 0 || sfc >= len(nSlen2) {
+			return nil, scalefacCompressError(0, ch, sfc)
+		}
+		var slen int
+		if intensity {
+			slen = iSlen2[sfc>>1]
+		} else {
+			slen = nSlen2[sfc]
+		}
 		sideInfo.Preflag[0][ch] = (slen >> 15) & 0x1
 
 		n := 0
@@ -134,6 +220,7 @@ func getScaleFactorsMpeg2(m *bits.Bits, header frameheader.FrameHeader, sideInfo
 		}
 
 		var scaleFactors []int
+		var illegal []bool
 		d := (slen >> 12) & 0x7
 
 		for i := 0; i < 4; i++ {
@@ -141,12 +228,16 @@ func getScaleFactorsMpeg2(m *bits.Bits, header frameheader.FrameHeader, sideInfo
 			slen >>= 3
 			if num > 0 {
 				for j := 0; j < scalefacSizesMpeg2[n][d][i]; j++ {
-					scaleFactors = append(scaleFactors, m.Bits(num))
+					sf := m.Bits(num)
+					scaleFactors = append(scaleFactors, sf)
+					illegal = append(illegal, sf == 1<<uint(num)-1)
 				}
 				numbits += scalefacSizesMpeg2[n][d][i] * num
 			} else {
 				for j := 0; j < scalefacSizesMpeg2[n][d][i]; j++ {
 					scaleFactors = append(scaleFactors, 0)
+					// A zero-length scale factor is always at the illegal position.
+					illegal = append(illegal, true)
 				}
 			}
 		}
@@ -154,37 +245,67 @@ func getScaleFactorsMpeg2(m *bits.Bits, header frameheader.FrameHeader, sideInfo
 		n = (n << 1) + 1
 		for i := 0; i < n; i++ {
 			scaleFactors = append(scaleFactors, 0)
+			illegal = append(illegal, false)
 		}
 
-		if len(scaleFactors) == 22 {
-			for i := 0; i < 22; i++ {
-				md.ScalefacL[0][ch][i] = scaleFactors[i]
-			}
-		} else {
+		switch n {
+		case 3:
+			// Short blocks
 			for x := 0; x < 13; x++ {
 				for i := 0; i < 3; i++ {
 					md.ScalefacS[0][ch][x][i] = scaleFactors[(x*3)+i]
+					if intensity {
+						md.IllegalPosS[x][i] = illegal[(x*3)+i]
+					}
+				}
+			}
+		case 5:
+			// Mixed blocks: 6 long scale factor bands cover the first 36 samples,
+			// and the short scale factor bands follow from the 4th band.
+			for i := 0; i < 6; i++ {
+				md.ScalefacL[0][ch][i] = scaleFactors[i]
+				if intensity {
+					md.IllegalPosL[i] = illegal[i]
+				}
+			}
+			for x := 3; x < 13; x++ {
+				for i := 0; i < 3; i++ {
+					md.ScalefacS[0][ch][x][i] = scaleFactors[6+(x-3)*3+i]
+					if intensity {
+						md.IllegalPosS[x][i] = illegal[6+(x-3)*3+i]
+					}
+				}
+			}
+		default:
+			// Long blocks
+			for i := 0; i < 22; i++ {
+				md.ScalefacL[0][ch][i] = scaleFactors[i]
+				if intensity {
+					md.IllegalPosL[i] = illegal[i]
 				}
 			}
 		}
 
 		// Read Huffman coded data. Skip stuffing bits.
-		if err := readHuffman(m, header, sideInfo, md, part_2_start, 0, ch); err != nil {
-			return nil, nil, err
+		if err := readHuffman(m, header, sideInfo, md, part_2_start, 0, ch, maxLines); err != nil {
+			return nil, err
 		}
 	}
 	// The ancillary data is stored here,but we ignore it.
-	return md, m, nil
+	return m, nil
 }
 
-func getScaleFactorsMpeg1(nch int, m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo) (*MainData, *bits.Bits, error) {
-	md := &MainData{}
+func getScaleFactorsMpeg1(md *MainData, nch int, m *bits.Bits, header frameheader.FrameHeader, sideInfo *sideinfo.SideInfo, maxLines int) (*bits.Bits, error) {
 	for gr := 0; gr < 2; gr++ {
 		for ch := 0; ch < nch; ch++ {
 			part_2_start := m.BitPos()
+			sfc := sideInfo.ScalefacCompress[gr][ch]
+			if sfc < 0 || sfc >= len(scalefacSizesMpeg1) {
+				return nil, scalefacCompressError(gr, ch, sfc)
+			}
 			// Number of bits in the bitstream for the bands
-			slen1 := scalefacSizesMpeg1[sideInfo.ScalefacCompress[gr][ch]][0]
-			slen2 := scalefacSizesMpeg1[sideInfo.ScalefacCompress[gr][ch]][1]
+			slen1 := scalefacSizesMpeg1[sfc][0]
+			slen2 := scalefacSizesMpeg1[sfc][1]
 			if sideInfo.WinSwitchFlag[gr][ch] == 1 && sideInfo.BlockType[gr][ch] == 2 {
 				if sideInfo.MixedBlockFlag[gr][ch] != 0 {
 					for sfb := 0; sfb < 8; sfb++ {
@@ -260,46 +381,64 @@ func getScaleFactorsMpeg1(nch int, m *bits.Bits, header frameheader.FrameHeader,
 				}
 			}
 			// Read Huffman coded data. Skip stuffing bits.
-			if err := readHuffman(m, header, sideInfo, md, part_2_start, gr, ch); err != nil {
-				return nil, nil, err
+			if err := readHuffman(m, header, sideInfo, md, part_2_start, gr, ch, maxLines); err != nil {
+				return nil, err
 			}
 		}
 	}
 	// The ancillary data is stored here,but we ignore it.
-	return md, m, nil
+	return m, nil
 }
 
-func read(source FullReader, prev *bits.Bits, size int, offset int) (*bits.Bits, error) {
-	if size > 1500 {
-		return nil, fmt.Errorf("mp3: size = %d", size)
+// underflow reports whether the main data refers back to more bytes than the bit reservoir prev has.
+func underflow(prev *bits.Bits, mainDataBegin int) bool {
+	if mainDataBegin == 0 {
+		return false
 	}
+	return prev == nil || mainDataBegin > prev.LenInBytes()
+}
+
+// read reads size bytes of the main data to the reservoir r, and returns them with offset bytes of the previous
+// main data prev. If partial is true and source ends before size bytes, read returns the available bytes and true.
+func read(source FullReader, r *Reservoir, prev *bits.Bits, size int, offset int, partial bool) (*bits.Bits, bool, error) {
+	if size > maxMainDataSize {
+		return nil, false, fmt.Errorf("%w: size = %d", consts.ErrInvalidMainData, size)
+	}
+	m, pos := r.next(prev)
 	// Check that there's data available from previous frames if needed
-	if prev != nil && offset > prev.LenInBytes() {
+	if underflow(prev, offset) {
 		// No, there is not, so we skip decoding this frame, but we have to
 		// read the main_data bits from the bitstream in case they are needed
 		// for decoding the next frame.
-		buf := make([]byte, size)
-		if n, err := source.ReadFull(buf); n < size {
+		if n, err := r.readAt(source, pos, size); n < size {
 			if err == io.EOF {
-				return nil, &consts.UnexpectedEOF{"maindata.Read (1)"}
+				return nil, false, &consts.UnexpectedEOF{"maindata.Read (1)"}
 			}
-			return nil, err
+			return nil, false, err
 		}
-		// TODO: Define a special error and enable to continue the next frame.
-		return bits.Append(prev, buf), nil
-	}
-	// Copy data from previous frames
-	vec := []byte{}
-	if prev != nil {
-		vec = prev.Tail(offset)
+		rest := 0
+		if prev != nil {
+			rest = prev.LenInBytes()
+			if rest > maxMainDataBegin {
+				rest = maxMainDataBegin
+			}
+		}
+		r.reset(m, pos, rest, size)
+		return m, false, nil
 	}
-	// Read the main_data from file
-	buf := make([]byte, size)
-	if n, err := source.ReadFull(buf); n < size {
-		if err == io.EOF {
-			return nil, &consts.UnexpectedEOF{"maindata.Read (2)"}
+	// Read the main_data from file after the data from previous frames
+	n, err := r.readAt(source, pos, size)
+	truncated := false
+	if n < size {
+		if err != io.EOF {
+			return nil, false, err
+		}
+		if !partial {
+			return nil, false, &consts.UnexpectedEOF{"maindata.Read (2)"}
 		}
-		return nil, err
+		// The bits beyond the available bytes are read as 0, and the Huffman decoder stops there.
+		truncated = true
 	}
-	return bits.New(append(vec, buf...)), nil
+	r.reset(m, pos, offset, n)
+	return m, truncated, nil
 }
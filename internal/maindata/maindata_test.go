@@ -0,0 +1,75 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maindata_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+	. "github.com/hajimehoshi/go-mp3/internal/maindata"
+	"github.com/hajimehoshi/go-mp3/internal/sideinfo"
+)
+
+type bytesReader struct {
+	buf []byte
+}
+
+func (r *bytesReader) ReadFull(buf []byte) (int, error) {
+	n := copy(buf, r.buf)
+	r.buf = r.buf[n:]
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func TestInvalidScalefacCompress(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Header frameheader.FrameHeader
+		Value  int
+	}{
+		{
+			// MPEG-1 Layer III, 128 kbps, 44100 Hz, mono
+			Name:   "MPEG-1",
+			Header: 0xfffb90c0,
+			Value:  16,
+		},
+		{
+			// MPEG-2 Layer III, 64 kbps, 22050 Hz, mono
+			Name:   "MPEG-2",
+			Header: 0xfff380c0,
+			Value:  512,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			si := &sideinfo.SideInfo{}
+			si.ScalefacCompress[0][0] = c.Value
+			_, _, err := Read(&bytesReader{make([]byte, 2048)}, &Reservoir{}, nil, c.Header, si, false, consts.SamplesPerGr, nil)
+			var verr *sideinfo.ValueError
+			if !errors.As(err, &verr) {
+				t.Fatalf("got: %v, want: *sideinfo.ValueError", err)
+			}
+			if verr.Field != "scalefac_compress" || verr.Value != c.Value {
+				t.Errorf("got: %+v, want: scalefac_compress = %d", verr, c.Value)
+			}
+		})
+	}
+}
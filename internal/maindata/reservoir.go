@@ -0,0 +1,82 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maindata
+
+import (
+	"github.com/hajimehoshi/go-mp3/internal/bits"
+)
+
+const (
+	// maxMainDataSize is the maximum size of the main data of a frame.
+	maxMainDataSize = 1500
+
+	// maxMainDataBegin is the maximum value of main_data_begin, which is a 9-bit field.
+	maxMainDataBegin = 511
+
+	// reservoirSize is the size of the ring buffer of a Reservoir, which must be larger than
+	// maxMainDataBegin+maxMainDataSize.
+	reservoirSize = 2048
+)
+
+// A Reservoir is the bit reservoir. A Reservoir keeps the main data of the recent frames in a ring buffer, so that
+// the main data of a frame is read next to the previous main data without copying them.
+//
+// The zero value is an empty reservoir.
+type Reservoir struct {
+	buf [reservoirSize]byte
+
+	// bits and end are the main data of the last two frames and their end positions in buf. They are used
+	// alternately so that the previous frame's main data is kept while the next frame's is read.
+	bits [2]bits.Bits
+	end  [2]int
+}
+
+// next returns the Bits for the main data after prev, and the position in buf to read it to.
+func (r *Reservoir) next(prev *bits.Bits) (*bits.Bits, int) {
+	switch prev {
+	case nil:
+		return &r.bits[0], 0
+	case &r.bits[0]:
+		return &r.bits[1], r.end[0]
+	default:
+		return &r.bits[0], r.end[1]
+	}
+}
+
+// readAt reads size bytes from source to buf at pos, wrapping around at the end of buf.
+// readAt returns the number of the read bytes, and an error if the number is less than size.
+func (r *Reservoir) readAt(source FullReader, pos, size int) (int, error) {
+	if pos+size <= len(r.buf) {
+		return source.ReadFull(r.buf[pos : pos+size])
+	}
+	n, err := source.ReadFull(r.buf[pos:])
+	if n < len(r.buf)-pos {
+		return n, err
+	}
+	n2, err := source.ReadFull(r.buf[:size-n])
+	return n + n2, err
+}
+
+// reset makes m read the n bytes read at pos with the preceding offset bytes.
+func (r *Reservoir) reset(m *bits.Bits, pos, offset, n int) {
+	start := (pos - offset) & (len(r.buf) - 1)
+	m.Reset(r.buf[:], start, offset+n)
+	end := (pos + n) & (len(r.buf) - 1)
+	if m == &r.bits[0] {
+		r.end[0] = end
+	} else {
+		r.end[1] = end
+	}
+}
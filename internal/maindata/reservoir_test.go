@@ -0,0 +1,87 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maindata
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/bits"
+)
+
+type fullReader struct {
+	r io.Reader
+}
+
+func (f fullReader) ReadFull(buf []byte) (int, error) {
+	return io.ReadFull(f.r, buf)
+}
+
+type zeroReader struct{}
+
+func (zeroReader) ReadFull(buf []byte) (int, error) {
+	for i := range buf {
+		buf[i] = 0
+	}
+	return len(buf), nil
+}
+
+func TestReservoir(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	stream := make([]byte, 100000)
+	rnd.Read(stream)
+	source := fullReader{bytes.NewReader(stream)}
+
+	r := &Reservoir{}
+	var prev *bits.Bits
+	pos := 0
+	for pos+maxMainDataSize <= len(stream) {
+		size := rnd.Intn(maxMainDataSize) + 1
+		offset := 0
+		if prev != nil {
+			offset = rnd.Intn(maxMainDataBegin + 1)
+			if n := prev.LenInBytes(); offset > n {
+				offset = n
+			}
+		}
+		m, _, err := read(source, r, prev, size, offset, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m == prev {
+			t.Fatalf("at %d: the previous main data must be kept", pos)
+		}
+		pos += size
+		if got, want := m.LenInBytes(), offset+size; got != want {
+			t.Fatalf("at %d: LenInBytes: got: %d, want: %d", pos, got, want)
+		}
+		for i, w := range stream[pos-size-offset : pos] {
+			if got := m.Bits(8); got != int(w) {
+				t.Fatalf("at %d: byte %d: got: 0x%02x, want: 0x%02x", pos, i, got, w)
+			}
+		}
+		prev = m
+	}
+
+	if n := testing.AllocsPerRun(10, func() {
+		if _, _, err := read(zeroReader{}, r, prev, maxMainDataSize, maxMainDataBegin, false); err != nil {
+			t.Fatal(err)
+		}
+	}); n != 0 {
+		t.Errorf("got: %f allocations, want: 0", n)
+	}
+}
@@ -51,8 +51,17 @@ type SideInfo struct {
 	ScalefacScale     [2][2]int // 1 bit
 	Count1TableSelect [2][2]int // 1 bit
 	Count1            [2][2]int // Not in file, calc by huffman decoder
+
+	// Raw is the side information in the bitstream.
+	Raw []byte
+
+	// raw is the buffer for Raw.
+	raw [maxSize]byte
 }
 
+// maxSize is the maximum size of the side information in bytes.
+const maxSize = 32
+
 var sideInfoBitsToRead = [2][4]int{
 	{ // MPEG 1
 		9, 5, 3, 4,
@@ -62,14 +71,18 @@ var sideInfoBitsToRead = [2][4]int{
 	},
 }
 
-func Read(source FullReader, header frameheader.FrameHeader) (*SideInfo, error) {
+// Read reads the side information of the frame header from source.
+//
+// si is the SideInfo to read the side information into, or nil. If si is nil, a new SideInfo is allocated.
+func Read(source FullReader, header frameheader.FrameHeader, si *SideInfo) (*SideInfo, error) {
 	nch := header.NumberOfChannels()
 	framesize, err := header.FrameSize()
 	if err != nil {
 		return nil, err
 	}
-	if framesize > 2000 {
-		return nil, fmt.Errorf("mp3: framesize = %d\n", framesize)
+	// frameheader.Read doesn't accept such headers.
+	if framesize > frameheader.MaxFrameSize {
+		return nil, fmt.Errorf("%w: framesize = %d", consts.ErrInvalidHeader, framesize)
 	}
 	sideinfo_size := header.SideInfoSize()
 
@@ -79,23 +92,30 @@ func Read(source FullReader, header frameheader.FrameHeader) (*SideInfo, error)
 	if header.ProtectionBit() == 0 {
 		main_data_size -= 2
 	}
+	if si == nil {
+		si = &SideInfo{}
+	} else {
+		// The fields not in the side information, e.g. the second channel of a mono frame, must be zero.
+		*si = SideInfo{}
+	}
 	// Read sideinfo from bitstream into buffer used by Bits()
-	buf := make([]byte, sideinfo_size)
+	buf := si.raw[:sideinfo_size]
 	n, err := source.ReadFull(buf)
 	if n < sideinfo_size {
 		if err == io.EOF {
 			return nil, &consts.UnexpectedEOF{"sideinfo.Read"}
 		}
-		return nil, fmt.Errorf("mp3: couldn't read sideinfo %d bytes: %v", sideinfo_size, err)
+		return nil, fmt.Errorf("mp3: couldn't read sideinfo %d bytes: %w", sideinfo_size, err)
 	}
-	s := bits.New(buf)
+	var s bits.Bits
+	s.Reset(buf, 0, len(buf))
 
 	mpeg1Frame := header.LowSamplingFrequency() == 0
 	bitsToRead := sideInfoBitsToRead[header.LowSamplingFrequency()]
 
 	// Parse audio data
 	// Pointer to where we should start reading main data
-	si := &SideInfo{}
+	si.Raw = buf
 	si.MainDataBegin = s.Bits(bitsToRead[0])
 	// Get private bits. Not used for anything.
 	if header.Mode() == consts.ModeSingleChannel {
@@ -157,5 +177,76 @@ func Read(source FullReader, header frameheader.FrameHeader) (*SideInfo, error)
 			si.Count1TableSelect[gr][ch] = s.Bits(1)
 		}
 	}
+	if err := si.validate(header); err != nil {
+		return nil, err
+	}
 	return si, nil
 }
+
+// A ValueError is returned when a field of the side information has a value out of its valid range.
+type ValueError struct {
+	Field   string
+	Granule int
+	Channel int
+	Value   int
+}
+
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("mp3: invalid side info: %s = %d at granule %d, channel %d", e.Field, e.Value, e.Granule, e.Channel)
+}
+
+// Unwrap returns consts.ErrInvalidSideInfo.
+func (e *ValueError) Unwrap() error {
+	return consts.ErrInvalidSideInfo
+}
+
+// maxScalefacCompress is the maximum scalefac_compress for MPEG-1 and for MPEG-2/2.5, which have 4 and 9 bits.
+var maxScalefacCompress = [2]int{15, 511}
+
+// validate checks that the values of si are in the ranges the decoder can handle.
+func (si *SideInfo) validate(header frameheader.FrameHeader) error {
+	long, _ := header.SfBandIndices()
+	for gr := 0; gr < header.Granules(); gr++ {
+		for ch := 0; ch < header.NumberOfChannels(); ch++ {
+			newErr := func(field string, value int) error {
+				return &ValueError{
+					Field:   field,
+					Granule: gr,
+					Channel: ch,
+					Value:   value,
+				}
+			}
+			// The scale factor lengths are looked up by scalefac_compress.
+			if v := si.ScalefacCompress[gr][ch]; v < 0 || v > maxScalefacCompress[header.LowSamplingFrequency()] {
+				return newErr("scalefac_compress", v)
+			}
+			// Two frequency lines per big value.
+			if v := si.BigValues[gr][ch]; v < 0 || v*2 > consts.SamplesPerGr {
+				return newErr("big_values", v)
+			}
+			if si.WinSwitchFlag[gr][ch] == 1 {
+				// Block type 0 (normal) is not allowed with window switching.
+				if v := si.BlockType[gr][ch]; v <= 0 || v > 3 {
+					return newErr("block_type", v)
+				}
+			} else {
+				// The region boundaries are looked up in the long block scale factor bands.
+				if v := si.Region0Count[gr][ch]; v < 0 || v+1 >= len(long) {
+					return newErr("region0_count", v)
+				}
+				if v := si.Region1Count[gr][ch]; v < 0 || si.Region0Count[gr][ch]+v+2 >= len(long) {
+					return newErr("region1_count", v)
+				}
+			}
+			for region := 0; region < 3; region++ {
+				if v := si.TableSelect[gr][ch][region]; v < 0 || v > 31 {
+					return newErr("table_select", v)
+				}
+			}
+			if v := si.Count1TableSelect[gr][ch]; v < 0 || v > 1 {
+				return newErr("count1table_select", v)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sideinfo_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+	. "github.com/hajimehoshi/go-mp3/internal/sideinfo"
+)
+
+// granule represents the fields of a granule in MPEG-1 mono side information.
+type granule struct {
+	bigValues    int
+	winSwitch    int
+	blockType    int
+	region0Count int
+	region1Count int
+}
+
+type bytesReader struct {
+	buf []byte
+}
+
+func (r *bytesReader) ReadFull(buf []byte) (int, error) {
+	n := copy(buf, r.buf)
+	r.buf = r.buf[n:]
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+type bitWriter struct {
+	buf []byte
+	n   int
+}
+
+func (w *bitWriter) write(v, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if w.n%8 == 0 {
+			w.buf = append(w.buf, 0)
+		}
+		w.buf[len(w.buf)-1] |= byte((v>>uint(i))&1) << uint(7-w.n%8)
+		w.n++
+	}
+}
+
+// mono44100 is the header of an MPEG-1 Layer III, 128 kbps, 44100 Hz, mono frame without CRC.
+const mono44100 = frameheader.FrameHeader(0xfffb90c0)
+
+func sideInfo(granules [2]granule) []byte {
+	w := &bitWriter{}
+	w.write(0, 9) // main_data_begin
+	w.write(0, 5) // private_bits
+	w.write(0, 4) // scfsi
+	for _, g := range granules {
+		w.write(0, 12) // part2_3_length
+		w.write(g.bigValues, 9)
+		w.write(0, 8) // global_gain
+		w.write(0, 4) // scalefac_compress
+		w.write(g.winSwitch, 1)
+		if g.winSwitch == 1 {
+			w.write(g.blockType, 2)
+			w.write(0, 1)  // mixed_block_flag
+			w.write(0, 10) // table_select
+			w.write(0, 9)  // subblock_gain
+		} else {
+			w.write(0, 15) // table_select
+			w.write(g.region0Count, 4)
+			w.write(g.region1Count, 3)
+		}
+		w.write(0, 3) // preflag, scalefac_scale and count1table_select
+	}
+	return w.buf
+}
+
+func TestValidation(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Granule  granule
+		Field    string
+		Accepted bool
+	}{
+		{
+			Name:     "valid",
+			Granule:  granule{bigValues: 288, region0Count: 15, region1Count: 5},
+			Accepted: true,
+		},
+		{
+			Name:    "big_values",
+			Granule: granule{bigValues: 289},
+			Field:   "big_values",
+		},
+		{
+			Name:    "block_type",
+			Granule: granule{winSwitch: 1, blockType: 0},
+			Field:   "block_type",
+		},
+		{
+			Name:    "region1_count",
+			Granule: granule{region0Count: 15, region1Count: 7},
+			Field:   "region1_count",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			buf := sideInfo([2]granule{{}, c.Granule})
+			_, err := Read(&bytesReader{buf}, mono44100, nil)
+			if c.Accepted {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var verr *ValueError
+			if !errors.As(err, &verr) {
+				t.Fatalf("got: %v, want: *ValueError", err)
+			}
+			if verr.Field != c.Field || verr.Granule != 1 || verr.Channel != 0 {
+				t.Errorf("got: %+v, want: field %s at granule 1, channel 0", verr, c.Field)
+			}
+		})
+	}
+}
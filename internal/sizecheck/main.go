@@ -0,0 +1,34 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// sizecheck is a minimal program that decodes MP3 from stdin to stdout.
+// This is used to track the binary size of the decoder.
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func main() {
+	d, err := mp3.NewDecoder(os.Stdin)
+	if err != nil {
+		os.Exit(1)
+	}
+	if _, err := io.Copy(os.Stdout, d); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xing parses and writes Xing, Info and VBRI headers stored in the first frame of a stream.
+package xing
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3/internal/crc"
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+)
+
+// Flags indicating which fields are present.
+const (
+	FlagFrames  = 0x1
+	FlagBytes   = 0x2
+	FlagTOC     = 0x4
+	FlagQuality = 0x8
+)
+
+// Header is a Xing or Info header.
+type Header struct {
+	// ID is "Xing" or "Info". LAME writes "Info" for CBR streams.
+	ID string
+
+	Flags uint32
+
+	// Frames is the number of audio frames excluding the frame of the header itself.
+	Frames uint32
+
+	// Bytes is the number of bytes of the stream including the frame of the header itself.
+	Bytes uint32
+
+	// TOC is the seek table. TOC[i] is the position at i percent of the duration, in 1/256 of Bytes.
+	TOC [100]byte
+
+	// Quality is the VBR quality indicator from 0 (best) to 100 (worst).
+	Quality uint32
+
+	// End is the offset in the frame just after the header.
+	End int
+
+	// LAME is the LAME tag following the header, or nil if absent.
+	LAME *LAME
+}
+
+// Offset returns the offset of a Xing header in a frame with the given frame header.
+func Offset(h frameheader.FrameHeader) int {
+	return 4 + h.SideInfoSize()
+}
+
+// Parse parses a Xing or Info header in the given frame including its frame header.
+//
+// Parse returns false when the frame doesn't have a Xing or Info header.
+func Parse(frame []byte) (*Header, bool) {
+	if len(frame) < 4 {
+		return nil, false
+	}
+	h := frameheader.FrameHeader(binary.BigEndian.Uint32(frame))
+	i := Offset(h)
+	if len(frame) < i+8 {
+		return nil, false
+	}
+	id := string(frame[i : i+4])
+	if id != "Xing" && id != "Info" {
+		return nil, false
+	}
+	x := &Header{
+		ID:    id,
+		Flags: binary.BigEndian.Uint32(frame[i+4:]),
+	}
+	i += 8
+
+	if x.Flags&FlagFrames != 0 {
+		if len(frame) < i+4 {
+			return nil, false
+		}
+		x.Frames = binary.BigEndian.Uint32(frame[i:])
+		i += 4
+	}
+	if x.Flags&FlagBytes != 0 {
+		if len(frame) < i+4 {
+			return nil, false
+		}
+		x.Bytes = binary.BigEndian.Uint32(frame[i:])
+		i += 4
+	}
+	if x.Flags&FlagTOC != 0 {
+		if len(frame) < i+len(x.TOC) {
+			return nil, false
+		}
+		copy(x.TOC[:], frame[i:])
+		i += len(x.TOC)
+	}
+	if x.Flags&FlagQuality != 0 {
+		if len(frame) < i+4 {
+			return nil, false
+		}
+		x.Quality = binary.BigEndian.Uint32(frame[i:])
+		i += 4
+	}
+	x.End = i
+	if l, ok := parseLAME(frame[i:]); ok {
+		x.LAME = l
+	}
+	return x, true
+}
+
+// Size returns the number of bytes of x including the LAME tag if any, without the frame header and the side
+// information.
+func (x *Header) Size() int {
+	n := 8
+	if x.Flags&FlagFrames != 0 {
+		n += 4
+	}
+	if x.Flags&FlagBytes != 0 {
+		n += 4
+	}
+	if x.Flags&FlagTOC != 0 {
+		n += len(x.TOC)
+	}
+	if x.Flags&FlagQuality != 0 {
+		n += 4
+	}
+	if x.LAME != nil {
+		n += lameSize
+	}
+	return n
+}
+
+// Frame returns a frame with the frame header h that has x and the LAME tag if any.
+//
+// The side information and the rest of the frame are zero, so the frame is decoded as silence. h should not have
+// a CRC as the CRC is not calculated. The CRC of the LAME tag is calculated. End of x is ignored.
+//
+// Frame returns false when x doesn't fit with the frame.
+func (x *Header) Frame(h frameheader.FrameHeader) ([]byte, bool) {
+	size, err := h.FrameSize()
+	if err != nil {
+		return nil, false
+	}
+	i := Offset(h)
+	if size < i+x.Size() {
+		return nil, false
+	}
+	frame := make([]byte, size)
+	binary.BigEndian.PutUint32(frame, uint32(h))
+	copy(frame[i:], x.ID)
+	binary.BigEndian.PutUint32(frame[i+4:], x.Flags)
+	i += 8
+
+	if x.Flags&FlagFrames != 0 {
+		binary.BigEndian.PutUint32(frame[i:], x.Frames)
+		i += 4
+	}
+	if x.Flags&FlagBytes != 0 {
+		binary.BigEndian.PutUint32(frame[i:], x.Bytes)
+		i += 4
+	}
+	if x.Flags&FlagTOC != 0 {
+		copy(frame[i:], x.TOC[:])
+		i += len(x.TOC)
+	}
+	if x.Flags&FlagQuality != 0 {
+		binary.BigEndian.PutUint32(frame[i:], x.Quality)
+		i += 4
+	}
+	if x.LAME != nil {
+		x.LAME.put(frame[i : i+lameSize])
+		// The CRC of the tag covers the frame from the beginning to just before the CRC.
+		binary.BigEndian.PutUint16(frame[i+34:], crc.UpdateLAME(0, frame[:i+34]))
+	}
+	return frame, true
+}
+
+// vbriOffset is the offset of a VBRI header in a frame. Unlike a Xing header, the offset doesn't depend on the
+// side information size.
+const vbriOffset = 4 + 32
+
+// ParseVBRI parses a VBRI header written by Fraunhofer encoders in the given frame including its frame header.
+//
+// The returned header has the number of frames, the number of bytes and the quality. The VBRI seek table is not
+// parsed.
+//
+// ParseVBRI returns false when the frame doesn't have a VBRI header.
+func ParseVBRI(frame []byte) (*Header, bool) {
+	// ID (4), version (2), delay (2), quality (2), bytes (4), frames (4)
+	const size = 18
+	if len(frame) < vbriOffset+size {
+		return nil, false
+	}
+	buf := frame[vbriOffset:]
+	if string(buf[:4]) != "VBRI" {
+		return nil, false
+	}
+	return &Header{
+		ID:      "VBRI",
+		Flags:   FlagFrames | FlagBytes | FlagQuality,
+		Quality: uint32(binary.BigEndian.Uint16(buf[8:])),
+		Bytes:   binary.BigEndian.Uint32(buf[10:]),
+		Frames:  binary.BigEndian.Uint32(buf[14:]),
+		End:     vbriOffset + size,
+	}, true
+}
+
+// LAME is a LAME tag, which is an extension of a Xing or Info header.
+type LAME struct {
+	// Encoder is the encoder version like "LAME3.100".
+	Encoder string
+
+	// Revision is the revision of the tag.
+	Revision int
+
+	// VBRMethod is the VBR method. 1 is CBR, 2 is ABR and 3-6 are VBR methods.
+	VBRMethod int
+
+	// Lowpass is the lowpass filter frequency in Hz, or 0 if unknown.
+	Lowpass int
+
+	// Bitrate is the bitrate in kbps: the target bitrate for ABR, the bitrate for CBR or the minimum bitrate for VBR.
+	// 255 means 255 kbps or more. 0 means unknown.
+	Bitrate int
+
+	// Preset is the preset value like 1000 for V0 in the recent LAME versions, or 0 if unknown.
+	Preset int
+
+	// Peak is the peak signal amplitude where 1 is the full scale, or 0 if unknown.
+	Peak float64
+
+	// TrackGain is the track (radio) ReplayGain adjustment in dB. TrackGain is valid only when HasTrackGain is true.
+	TrackGain    float64
+	HasTrackGain bool
+
+	// AlbumGain is the album (audiophile) ReplayGain adjustment in dB. AlbumGain is valid only when HasAlbumGain is
+	// true.
+	AlbumGain    float64
+	HasAlbumGain bool
+
+	// Delay is the number of samples the encoder added at the beginning.
+	Delay int
+
+	// Padding is the number of samples the encoder added at the end.
+	Padding int
+
+	// MusicLength is the number of bytes from the first frame to the last frame.
+	MusicLength uint32
+
+	// MusicCRC is the CRC of the audio frames following the frame of the header. See UpdateCRC.
+	MusicCRC uint16
+}
+
+const lameSize = 36
+
+// ReplayGain name codes
+const (
+	replayGainTrack = 1
+	replayGainAlbum = 2
+)
+
+func parseLAME(buf []byte) (*LAME, bool) {
+	if len(buf) < lameSize {
+		return nil, false
+	}
+	// Accept only printable encoder names like "LAME3.100" or "Lavc58.35".
+	for _, c := range buf[:4] {
+		if c < 0x20 || c > 0x7e {
+			return nil, false
+		}
+	}
+	l := &LAME{
+		Encoder:     strings.TrimRight(string(buf[:9]), "\x00 "),
+		Revision:    int(buf[9] >> 4),
+		VBRMethod:   int(buf[9] & 0xf),
+		Lowpass:     int(buf[10]) * 100,
+		Peak:        float64(binary.BigEndian.Uint32(buf[11:])) / (1 << 23),
+		Bitrate:     int(buf[20]),
+		Delay:       int(buf[21])<<4 | int(buf[22])>>4,
+		Padding:     int(buf[22]&0xf)<<8 | int(buf[23]),
+		Preset:      int(binary.BigEndian.Uint16(buf[26:]) & 0x7ff),
+		MusicLength: binary.BigEndian.Uint32(buf[28:]),
+		MusicCRC:    binary.BigEndian.Uint16(buf[32:]),
+	}
+	for _, g := range [][]byte{buf[15:17], buf[17:19]} {
+		v := binary.BigEndian.Uint16(g)
+		gain := float64(v&0x1ff) / 10
+		if v&0x200 != 0 {
+			gain = -gain
+		}
+		switch v >> 13 {
+		case replayGainTrack:
+			l.TrackGain = gain
+			l.HasTrackGain = true
+		case replayGainAlbum:
+			l.AlbumGain = gain
+			l.HasAlbumGain = true
+		}
+	}
+	return l, true
+}
+
+// put writes l to buf except for the CRC of the tag.
+func (l *LAME) put(buf []byte) {
+	copy(buf[:9], l.Encoder)
+	buf[9] = byte(l.Revision&0xf)<<4 | byte(l.VBRMethod&0xf)
+	buf[10] = byte(clamp((l.Lowpass+50)/100, 0, 0xff))
+	binary.BigEndian.PutUint32(buf[11:], uint32(math.Round(math.Max(l.Peak, 0)*(1<<23))))
+	if l.HasTrackGain {
+		binary.BigEndian.PutUint16(buf[15:], replayGain(replayGainTrack, l.TrackGain))
+	}
+	if l.HasAlbumGain {
+		binary.BigEndian.PutUint16(buf[17:], replayGain(replayGainAlbum, l.AlbumGain))
+	}
+	buf[20] = byte(clamp(l.Bitrate, 0, 0xff))
+	delay := clamp(l.Delay, 0, 0xfff)
+	padding := clamp(l.Padding, 0, 0xfff)
+	buf[21] = byte(delay >> 4)
+	buf[22] = byte(delay&0xf)<<4 | byte(padding>>8)
+	buf[23] = byte(padding)
+	binary.BigEndian.PutUint16(buf[26:], uint16(l.Preset&0x7ff))
+	binary.BigEndian.PutUint32(buf[28:], l.MusicLength)
+	binary.BigEndian.PutUint16(buf[32:], l.MusicCRC)
+}
+
+// replayGainOriginatorAutomatic is the originator code of a ReplayGain value determined automatically.
+const replayGainOriginatorAutomatic = 3
+
+// replayGain returns a ReplayGain field in a LAME tag.
+func replayGain(name int, gain float64) uint16 {
+	v := uint16(name)<<13 | replayGainOriginatorAutomatic<<10
+	if gain < 0 {
+		v |= 0x200
+	}
+	return v | uint16(clamp(int(math.Round(math.Abs(gain)*10)), 0, 0x1ff))
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
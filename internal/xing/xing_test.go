@@ -0,0 +1,171 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xing_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3/internal/crc"
+	. "github.com/hajimehoshi/go-mp3/internal/xing"
+)
+
+func TestParse(t *testing.T) {
+	// MPEG-1 Layer III, 128 kbps, 44100 Hz, stereo
+	frame := make([]byte, 417)
+	binary.BigEndian.PutUint32(frame, 0xfffb9004)
+	const offset = 4 + 32
+	copy(frame[offset:], "Info")
+	// Frames and bytes only.
+	binary.BigEndian.PutUint32(frame[offset+4:], FlagFrames|FlagBytes)
+	binary.BigEndian.PutUint32(frame[offset+8:], 1000)
+	binary.BigEndian.PutUint32(frame[offset+12:], 417000)
+
+	lame := frame[offset+16:]
+	copy(lame, "LAME3.100")
+	lame[9] = 0x01                                    // Revision 0, CBR
+	lame[10] = 0xb9                                   // 18500 Hz
+	binary.BigEndian.PutUint32(lame[11:], 0x00400000) // 0.5
+	binary.BigEndian.PutUint16(lame[15:], 0x2e41)     // Track, -6.5 dB
+	binary.BigEndian.PutUint16(lame[17:], 0x4c20)     // Album, +3.2 dB
+	lame[20] = 128                                    // 128 kbps
+	// Delay 576, padding 1104
+	lame[21] = 0x24
+	lame[22] = 0x04
+	lame[23] = 0x50
+	binary.BigEndian.PutUint16(lame[26:], 1001) // Preset 1001, which is V1
+
+	x, ok := Parse(frame)
+	if !ok {
+		t.Fatal("Parse failed")
+	}
+	if x.ID != "Info" || x.Frames != 1000 || x.Bytes != 417000 || x.End != offset+16 {
+		t.Errorf("got: %+v", x)
+	}
+	l := x.LAME
+	if l == nil {
+		t.Fatal("LAME tag is not found")
+	}
+	if got, want := *l, (LAME{
+		Encoder:      "LAME3.100",
+		VBRMethod:    1,
+		Lowpass:      18500,
+		Bitrate:      128,
+		Preset:       1001,
+		Peak:         0.5,
+		TrackGain:    -6.5,
+		HasTrackGain: true,
+		AlbumGain:    3.2,
+		HasAlbumGain: true,
+		Delay:        576,
+		Padding:      1104,
+	}); got != want {
+		t.Errorf("LAME: got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestFrame(t *testing.T) {
+	x := &Header{
+		ID:      "Xing",
+		Flags:   FlagFrames | FlagBytes | FlagTOC | FlagQuality,
+		Frames:  1000,
+		Bytes:   417000,
+		Quality: 78,
+		LAME: &LAME{
+			Encoder:      "LAME3.100",
+			Revision:     1,
+			VBRMethod:    4,
+			Lowpass:      19500,
+			Bitrate:      32,
+			Preset:       1002,
+			Peak:         0.75,
+			TrackGain:    -6.5,
+			HasTrackGain: true,
+			Delay:        576,
+			Padding:      1104,
+			MusicLength:  417000,
+			MusicCRC:     0x1234,
+		},
+	}
+	for i := range x.TOC {
+		x.TOC[i] = byte(i * 2)
+	}
+
+	// MPEG-1 Layer III, 128 kbps, 44100 Hz, stereo
+	const h = 0xfffb9000
+	frame, ok := x.Frame(h)
+	if !ok {
+		t.Fatal("Frame failed")
+	}
+	if got, want := len(frame), 417; got != want {
+		t.Errorf("len(frame): got: %d, want: %d", got, want)
+	}
+	got, ok := Parse(frame)
+	if !ok {
+		t.Fatal("Parse failed")
+	}
+	if got.LAME == nil {
+		t.Fatal("LAME tag is not found")
+	}
+	if *got.LAME != *x.LAME {
+		t.Errorf("LAME: got: %+v, want: %+v", *got.LAME, *x.LAME)
+	}
+	want := *x
+	want.End = 4 + 32 + 120
+	got.LAME, want.LAME = nil, nil
+	if *got != want {
+		t.Errorf("got: %+v, want: %+v", *got, want)
+	}
+
+	// The CRC of the tag covers the first 190 bytes for a stereo MPEG-1 frame.
+	if got, want := binary.BigEndian.Uint16(frame[190:]), crc.UpdateLAME(0, frame[:190]); got != want {
+		t.Errorf("tag CRC: got: 0x%04x, want: 0x%04x", got, want)
+	}
+
+	// 32 kbps is too small for the header.
+	if _, ok := x.Frame(0xfffb1000); ok {
+		t.Errorf("Frame with a too small frame must fail")
+	}
+}
+
+func TestParseNoHeader(t *testing.T) {
+	frame := make([]byte, 417)
+	binary.BigEndian.PutUint32(frame, 0xfffb9004)
+	if _, ok := Parse(frame); ok {
+		t.Errorf("Parse succeeded unexpectedly")
+	}
+}
+
+func TestParseVBRI(t *testing.T) {
+	frame := make([]byte, 417)
+	binary.BigEndian.PutUint32(frame, 0xfffb9004)
+	const offset = 4 + 32
+	copy(frame[offset:], "VBRI")
+	binary.BigEndian.PutUint16(frame[offset+4:], 1)
+	binary.BigEndian.PutUint16(frame[offset+8:], 75)
+	binary.BigEndian.PutUint32(frame[offset+10:], 417000)
+	binary.BigEndian.PutUint32(frame[offset+14:], 1000)
+
+	x, ok := ParseVBRI(frame)
+	if !ok {
+		t.Fatal("ParseVBRI failed")
+	}
+	if x.ID != "VBRI" || x.Frames != 1000 || x.Bytes != 417000 || x.Quality != 75 {
+		t.Errorf("got: %+v", x)
+	}
+	if _, ok := Parse(frame); ok {
+		t.Errorf("Parse succeeded unexpectedly")
+	}
+}
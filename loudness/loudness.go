@@ -0,0 +1,353 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loudness measures the loudness of stereo audio by ITU-R BS.1770 and EBU R128: the integrated loudness, the
+// loudness range and the true peak, and the ReplayGain adjustment from them.
+//
+// A Meter is an io.Writer of the PCM of a decoder, so a stream can be measured like this:
+//
+//	m := loudness.NewMeter(d.SampleRate())
+//	if _, err := d.WriteTo(m); err != nil {
+//		return err
+//	}
+//	lufs, ok := m.Integrated()
+package loudness
+
+import (
+	"math"
+)
+
+const (
+	absoluteGate = -70.0
+	relativeGate = -10.0
+
+	// rangeRelativeGate is the relative gate for the loudness range by EBU Tech 3342.
+	rangeRelativeGate = -20.0
+
+	// The percentiles of the short-term loudness distribution for the loudness range.
+	rangeLowPercentile  = 0.10
+	rangeHighPercentile = 0.95
+
+	histogramMin  = absoluteGate
+	histogramMax  = 10.0
+	histogramStep = 0.1
+	histogramSize = int((histogramMax - histogramMin) / histogramStep)
+)
+
+// biquad is a second order IIR filter in the direct form I.
+type biquad struct {
+	b0, b1, b2 float64
+	a1, a2     float64
+	x1, x2     float64
+	y1, y2     float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.b1*f.x1 + f.b2*f.x2 - f.a1*f.y1 - f.a2*f.y2
+	f.x2 = f.x1
+	f.x1 = x
+	f.y2 = f.y1
+	f.y1 = y
+	return y
+}
+
+// kWeighting returns the two stages of the K-weighting filter for the sample rate.
+// The coefficients are derived for an arbitrary sample rate in the same way as libebur128.
+func kWeighting(sampleRate int) (shelf, highpass biquad) {
+	{
+		const (
+			f0 = 1681.974450955533
+			g  = 3.999843853973347
+			q  = 0.7071752369554196
+		)
+		k := math.Tan(math.Pi * f0 / float64(sampleRate))
+		vh := math.Pow(10, g/20)
+		vb := math.Pow(vh, 0.4996667741545416)
+		a0 := 1 + k/q + k*k
+		shelf = biquad{
+			b0: (vh + vb*k/q + k*k) / a0,
+			b1: 2 * (k*k - vh) / a0,
+			b2: (vh - vb*k/q + k*k) / a0,
+			a1: 2 * (k*k - 1) / a0,
+			a2: (1 - k/q + k*k) / a0,
+		}
+	}
+	{
+		const (
+			f0 = 38.13547087602444
+			q  = 0.5003270373238773
+		)
+		k := math.Tan(math.Pi * f0 / float64(sampleRate))
+		a0 := 1 + k/q + k*k
+		highpass = biquad{
+			b0: 1,
+			b1: -2,
+			b2: 1,
+			a1: 2 * (k*k - 1) / a0,
+			a2: (1 - k/q + k*k) / a0,
+		}
+	}
+	return
+}
+
+// histogram is a histogram of the loudness of gating blocks in 0.1 LU bins from the absolute gate.
+// The block energies are accumulated too so that the mean energy is calculated precisely.
+type histogram struct {
+	counts   [histogramSize]int
+	energies [histogramSize]float64
+
+	// aboveAbsolute and energyAbsolute are the number and the total energy of the blocks above the absolute gate.
+	aboveAbsolute  int
+	energyAbsolute float64
+}
+
+// add adds a block of the energy e.
+func (h *histogram) add(e float64) {
+	l := energyToLoudness(e)
+	if l < absoluteGate {
+		return
+	}
+	i := int((l - histogramMin) / histogramStep)
+	if i >= histogramSize {
+		i = histogramSize - 1
+	}
+	h.counts[i]++
+	h.energies[i] += e
+	h.aboveAbsolute++
+	h.energyAbsolute += e
+}
+
+// gate returns the first bin above the relative gate of gain in LU and the number of the blocks above the gate.
+func (h *histogram) gate(gain float64) (int, int) {
+	if h.aboveAbsolute == 0 {
+		return 0, 0
+	}
+	threshold := energyToLoudness(h.energyAbsolute/float64(h.aboveAbsolute)) + gain
+	start := int(math.Ceil((threshold - histogramMin) / histogramStep))
+	if start < 0 {
+		start = 0
+	}
+	n := 0
+	for i := start; i < histogramSize; i++ {
+		n += h.counts[i]
+	}
+	return start, n
+}
+
+// percentile returns the bin of the percentile p of the n blocks from the bin start.
+func (h *histogram) percentile(start, n int, p float64) int {
+	k := int(float64(n-1)*p + 0.5)
+	for i := start; i < histogramSize; i++ {
+		if k < h.counts[i] {
+			return i
+		}
+		k -= h.counts[i]
+	}
+	return histogramSize - 1
+}
+
+// Steps of 100ms for the gating blocks.
+const (
+	momentarySteps = 4
+	shortTermSteps = 30
+)
+
+// ReplayGainReference is the reference loudness in LUFS of ReplayGain 2.0.
+const ReplayGainReference = -18
+
+// Meter measures the loudness of 2-channel audio.
+//
+// The gating blocks for the integrated loudness are 400ms long with 75% overlap, and the short-term blocks for the
+// loudness range are 3s long with a step of 100ms.
+// The block energies are accumulated into histograms so that the memory usage doesn't depend on the stream length.
+type Meter struct {
+	shelf    [2]biquad
+	highpass [2]biquad
+
+	stepSize  int
+	stepCount int
+	stepSum   float64
+
+	// steps is the energies of the last steps of 100ms.
+	steps     [shortTermSteps]float64
+	stepIndex int
+	stepsSeen int
+
+	momentary histogram
+	shortTerm histogram
+
+	samplePeak float64
+	truePeak   float64
+	oversample *oversampler
+
+	// partial is the bytes of an incomplete sample written last.
+	partial  [4]byte
+	npartial int
+}
+
+// NewMeter returns a new Meter for the sample rate.
+func NewMeter(sampleRate int) *Meter {
+	m := &Meter{
+		stepSize:   sampleRate / 10,
+		oversample: newOversampler(sampleRate),
+	}
+	shelf, highpass := kWeighting(sampleRate)
+	for ch := 0; ch < 2; ch++ {
+		m.shelf[ch] = shelf
+		m.highpass[ch] = highpass
+	}
+	return m
+}
+
+// Add adds a stereo sample in the range [-1, 1].
+func (m *Meter) Add(left, right float64) {
+	m.addPeak(left, right)
+
+	kl := m.highpass[0].process(m.shelf[0].process(left))
+	kr := m.highpass[1].process(m.shelf[1].process(right))
+	m.stepSum += kl*kl + kr*kr
+	m.stepCount++
+	if m.stepCount < m.stepSize {
+		return
+	}
+
+	m.steps[m.stepIndex] = m.stepSum / float64(m.stepSize)
+	m.stepIndex = (m.stepIndex + 1) % len(m.steps)
+	m.stepSum = 0
+	m.stepCount = 0
+	m.stepsSeen++
+	if m.stepsSeen >= momentarySteps {
+		m.momentary.add(m.lastSteps(momentarySteps))
+	}
+	if m.stepsSeen >= shortTermSteps {
+		m.shortTerm.add(m.lastSteps(shortTermSteps))
+	}
+}
+
+// lastSteps returns the mean energy of the last n steps.
+func (m *Meter) lastSteps(n int) float64 {
+	var e float64
+	for i := 1; i <= n; i++ {
+		e += m.steps[(m.stepIndex-i+len(m.steps))%len(m.steps)]
+	}
+	return e / float64(n)
+}
+
+func (m *Meter) addPeak(left, right float64) {
+	for _, v := range [...]float64{left, right} {
+		if v := math.Abs(v); v > m.samplePeak {
+			m.samplePeak = v
+		}
+	}
+	if m.oversample != nil {
+		if p := m.oversample.process(left, right); p > m.truePeak {
+			m.truePeak = p
+		}
+	}
+}
+
+// AddFloat32 adds interleaved 2-channel samples in the range [-1, 1].
+func (m *Meter) AddFloat32(samples []float32) {
+	for i := 0; i+1 < len(samples); i += 2 {
+		m.Add(float64(samples[i]), float64(samples[i+1]))
+	}
+}
+
+// AddInt16 adds 16bit little endian 2-channel samples.
+func (m *Meter) AddInt16(pcm []byte) {
+	for i := 0; i+3 < len(pcm); i += 4 {
+		m.addInt16(pcm[i : i+4])
+	}
+}
+
+func (m *Meter) addInt16(sample []byte) {
+	l := float64(int16(uint16(sample[0])|uint16(sample[1])<<8)) / 32768
+	r := float64(int16(uint16(sample[2])|uint16(sample[3])<<8)) / 32768
+	m.Add(l, r)
+}
+
+// Write adds 16bit little endian 2-channel samples like AddInt16. Write always succeeds.
+//
+// Unlike AddInt16, the PCM doesn't have to be aligned to samples. An incomplete sample is added with the next Write.
+func (m *Meter) Write(pcm []byte) (int, error) {
+	n := len(pcm)
+	if m.npartial > 0 {
+		c := copy(m.partial[m.npartial:], pcm)
+		m.npartial += c
+		pcm = pcm[c:]
+		if m.npartial < len(m.partial) {
+			return n, nil
+		}
+		m.addInt16(m.partial[:])
+		m.npartial = 0
+	}
+	aligned := len(pcm) &^ 3
+	m.AddInt16(pcm[:aligned])
+	m.npartial = copy(m.partial[:], pcm[aligned:])
+	return n, nil
+}
+
+// Integrated returns the gated integrated loudness in LUFS measured so far.
+// Integrated returns false when no gating block has passed the absolute gate yet.
+func (m *Meter) Integrated() (float64, bool) {
+	h := &m.momentary
+	start, n := h.gate(relativeGate)
+	if n == 0 {
+		return 0, false
+	}
+	e := 0.0
+	for i := start; i < histogramSize; i++ {
+		e += h.energies[i]
+	}
+	return energyToLoudness(e / float64(n)), true
+}
+
+// LoudnessRange returns the loudness range in LU by EBU Tech 3342 measured so far, which is the difference between
+// the 10th and the 95th percentiles of the gated short-term loudness. The resolution is 0.1 LU.
+// LoudnessRange returns false when no short-term block has passed the absolute gate yet.
+func (m *Meter) LoudnessRange() (float64, bool) {
+	h := &m.shortTerm
+	start, n := h.gate(rangeRelativeGate)
+	if n == 0 {
+		return 0, false
+	}
+	low := h.percentile(start, n, rangeLowPercentile)
+	high := h.percentile(start, n, rangeHighPercentile)
+	return float64(high-low) * histogramStep, true
+}
+
+// SamplePeak returns the maximum absolute sample value where 1 is the full scale.
+func (m *Meter) SamplePeak() float64 {
+	return m.samplePeak
+}
+
+// TruePeak returns the true peak by ITU-R BS.1770 where 1 is the full scale, which is the peak of the signal
+// oversampled to at least 192kHz. The true peak is at least the sample peak. 20*math.Log10 of the value is in dBTP.
+func (m *Meter) TruePeak() float64 {
+	return math.Max(m.truePeak, m.samplePeak)
+}
+
+// ReplayGain returns the ReplayGain 2.0 adjustment in dB, which makes the integrated loudness
+// ReplayGainReference. ReplayGain returns false when Integrated returns false.
+func (m *Meter) ReplayGain() (float64, bool) {
+	l, ok := m.Integrated()
+	if !ok {
+		return 0, false
+	}
+	return ReplayGainReference - l, true
+}
+
+func energyToLoudness(e float64) float64 {
+	return -0.691 + 10*math.Log10(e)
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loudness_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/loudness"
+)
+
+func TestSine(t *testing.T) {
+	for _, sampleRate := range []int{22050, 44100, 48000} {
+		m := NewMeter(sampleRate)
+		// A 1kHz sine wave at -20 dBFS in both channels is -20 LUFS.
+		for i := 0; i < sampleRate*5; i++ {
+			v := 0.1 * math.Sin(2*math.Pi*1000*float64(i)/float64(sampleRate))
+			m.Add(v, v)
+		}
+		l, ok := m.Integrated()
+		if !ok {
+			t.Fatalf("sample rate %d: Integrated failed", sampleRate)
+		}
+		if math.Abs(l-(-20)) > 0.1 {
+			t.Errorf("sample rate %d: got: %f LUFS, want: -20 LUFS", sampleRate, l)
+		}
+	}
+}
+
+func TestSilence(t *testing.T) {
+	m := NewMeter(44100)
+	for i := 0; i < 44100; i++ {
+		m.Add(0, 0)
+	}
+	if _, ok := m.Integrated(); ok {
+		t.Errorf("Integrated for silence must fail")
+	}
+}
+
+// sine returns a 1kHz sine wave of the amplitude in dBFS and the duration in seconds.
+func sine(sampleRate int, dbfs float64, seconds int) []float32 {
+	a := math.Pow(10, dbfs/20)
+	samples := make([]float32, 0, sampleRate*seconds*2)
+	for i := 0; i < sampleRate*seconds; i++ {
+		v := float32(a * math.Sin(2*math.Pi*1000*float64(i)/float64(sampleRate)))
+		samples = append(samples, v, v)
+	}
+	return samples
+}
+
+func TestLoudnessRange(t *testing.T) {
+	// The case 1 of EBU Tech 3342: 20s at -20 dBFS followed by 20s at -30 dBFS is 10 LU.
+	m := NewMeter(48000)
+	m.AddFloat32(sine(48000, -20, 20))
+	m.AddFloat32(sine(48000, -30, 20))
+	lra, ok := m.LoudnessRange()
+	if !ok {
+		t.Fatal("LoudnessRange failed")
+	}
+	if math.Abs(lra-10) > 1 {
+		t.Errorf("got: %f LU, want: 10 LU", lra)
+	}
+
+	// A constant signal has no range.
+	m = NewMeter(48000)
+	m.AddFloat32(sine(48000, -20, 10))
+	if lra, ok := m.LoudnessRange(); !ok || lra > 0.1 {
+		t.Errorf("got: %f LU, %t, want: 0 LU, true", lra, ok)
+	}
+}
+
+func TestTruePeak(t *testing.T) {
+	// A sine wave at a quarter of the sample rate with the phase of 45 degrees has samples at 1/sqrt(2) of its
+	// amplitude.
+	const sampleRate = 48000
+	m := NewMeter(sampleRate)
+	for i := 0; i < sampleRate; i++ {
+		v := 0.5 * math.Sin(math.Pi*float64(i)/2+math.Pi/4)
+		m.Add(v, -v)
+	}
+	if got, want := m.SamplePeak(), 0.5/math.Sqrt2; math.Abs(got-want) > 1e-6 {
+		t.Errorf("SamplePeak: got: %f, want: %f", got, want)
+	}
+	if got, want := m.TruePeak(), 0.5; math.Abs(got-want) > 0.01 {
+		t.Errorf("TruePeak: got: %f, want: %f", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	pcm := make([]byte, 44100*4)
+	for i := 0; i < len(pcm)/2; i++ {
+		v := int16(10000 * math.Sin(2*math.Pi*1000*float64(i/2)/44100))
+		pcm[2*i] = byte(v)
+		pcm[2*i+1] = byte(v >> 8)
+	}
+	want := NewMeter(44100)
+	want.AddInt16(pcm)
+
+	// Write in odd sizes so that samples are split across the writes.
+	got := NewMeter(44100)
+	for buf := pcm; len(buf) > 0; {
+		n := 4095
+		if n > len(buf) {
+			n = len(buf)
+		}
+		got.Write(buf[:n])
+		buf = buf[n:]
+	}
+	gl, _ := got.Integrated()
+	wl, _ := want.Integrated()
+	if gl != wl || got.TruePeak() != want.TruePeak() {
+		t.Errorf("got: %f LUFS, %f, want: %f LUFS, %f", gl, got.TruePeak(), wl, want.TruePeak())
+	}
+}
+
+func TestReplayGain(t *testing.T) {
+	m := NewMeter(44100)
+	m.AddFloat32(sine(44100, -20, 5))
+	g, ok := m.ReplayGain()
+	if !ok {
+		t.Fatal("ReplayGain failed")
+	}
+	// -20 LUFS is 2 dB below the reference.
+	if math.Abs(g-2) > 0.1 {
+		t.Errorf("got: %f dB, want: 2 dB", g)
+	}
+}
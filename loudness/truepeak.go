@@ -0,0 +1,89 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loudness
+
+import (
+	"math"
+)
+
+// oversamplerTaps is the number of the taps of the interpolation filter for the true peak.
+const oversamplerTaps = 49
+
+// oversampler interpolates 2-channel audio with a polyphase FIR filter, whose coefficients are a windowed sinc
+// function like libebur128.
+type oversampler struct {
+	// phases[p] is the coefficients of the phase p, which are applied to the latest samples in order.
+	phases [][]float64
+
+	// history is the last samples of each channel. The samples are stored twice so that the latest samples are
+	// always contiguous.
+	history [2][]float64
+	index   int
+}
+
+// newOversampler returns an oversampler to at least 192kHz for the sample rate,
+// or nil if the sample rate is already high enough.
+func newOversampler(sampleRate int) *oversampler {
+	factor := 4
+	if sampleRate >= 96000 {
+		factor = 2
+	}
+	if sampleRate >= 192000 {
+		return nil
+	}
+
+	o := &oversampler{
+		phases: make([][]float64, factor),
+	}
+	const m = oversamplerTaps - 1
+	for j := 0; j < oversamplerTaps; j++ {
+		c := 1.0
+		if x := math.Pi * float64(j-m/2) / float64(factor); x != 0 {
+			c = math.Sin(x) / x
+		}
+		// Hann window
+		c *= 0.5 * (1 - math.Cos(2*math.Pi*float64(j)/m))
+		o.phases[j%factor] = append(o.phases[j%factor], c)
+	}
+	n := len(o.phases[0])
+	for ch := range o.history {
+		o.history[ch] = make([]float64, 2*n)
+	}
+	return o
+}
+
+// process adds a sample and returns the maximum absolute value of the interpolated samples.
+func (o *oversampler) process(left, right float64) float64 {
+	n := len(o.phases[0])
+	o.index = (o.index + 1) % n
+	var peak float64
+	for ch, v := range [...]float64{left, right} {
+		h := o.history[ch]
+		h[o.index] = v
+		h[o.index+n] = v
+		// latest[n-1] is the latest sample.
+		latest := h[o.index+1 : o.index+n+1]
+		for _, p := range o.phases {
+			var y float64
+			for k, c := range p {
+				y += c * latest[n-1-k]
+			}
+			if y := math.Abs(y); y > peak {
+				peak = y
+			}
+		}
+	}
+	return peak
+}
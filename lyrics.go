@@ -0,0 +1,157 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3/internal/id3"
+)
+
+// Lyrics represents unsynchronized lyrics from an ID3v2 USLT frame.
+type Lyrics struct {
+	// Language is the ISO-639-2 language code like "eng".
+	Language string
+
+	// Description is the content descriptor, which distinguishes multiple lyrics in the same language.
+	Description string
+
+	Text string
+}
+
+// SyncedLyrics represents synchronized lyrics or text from an ID3v2 SYLT frame.
+type SyncedLyrics struct {
+	// Language is the ISO-639-2 language code like "eng".
+	Language string
+
+	// Description is the content descriptor, which distinguishes multiple lyrics in the same language.
+	Description string
+
+	// ContentType is the type of the content: 1 for lyrics, 2 for text transcription, 3 for movement names, 4 for
+	// events, 5 for chords, 6 for trivia, 7 for URLs of web pages and 8 for URLs of images. 0 is other.
+	ContentType int
+
+	// Lines are the timestamped lines sorted by the time.
+	Lines []LyricLine
+}
+
+// LyricLine is a line of synchronized lyrics.
+type LyricLine struct {
+	// Time is the time the line starts at in the decoded stream.
+	Time time.Duration
+
+	Text string
+}
+
+// LineAt returns the index of the line at t, which is the last line starting at or before t.
+// LineAt returns -1 if t is before the first line.
+//
+// t is typically the decoder's time position, e.g. the offset in bytes converted by the sample rate.
+func (s *SyncedLyrics) LineAt(t time.Duration) int {
+	return sort.Search(len(s.Lines), func(i int) bool {
+		return s.Lines[i].Time > t
+	}) - 1
+}
+
+// Lyrics returns the unsynchronized lyrics in the ID3v2 tag at the beginning of the stream.
+//
+// Lyrics returns nil if there are no lyrics.
+func (d *Decoder) Lyrics() []Lyrics {
+	if d.source.id3v2 == nil {
+		return nil
+	}
+	tag, err := id3.Parse(d.source.id3v2)
+	if err != nil {
+		return nil
+	}
+
+	var lyrics []Lyrics
+	for _, f := range tag.Frames {
+		if id3.CanonicalID(f.ID, tag.MajorVersion) != "USLT" {
+			continue
+		}
+		// USLT has the same structure as COMM.
+		c, err := id3.ParseComment(f.Data)
+		if err != nil {
+			continue
+		}
+		lyrics = append(lyrics, Lyrics{
+			Language:    c.Language,
+			Description: c.Description,
+			Text:        c.Text,
+		})
+	}
+	return lyrics
+}
+
+// SyncedLyrics returns the synchronized lyrics in the ID3v2 tag at the beginning of the stream.
+//
+// The timestamps in MPEG frames are converted to the time with the number of samples per frame of the stream.
+// Note that the time doesn't count the samples trimmed for gapless playback.
+//
+// SyncedLyrics returns nil if there are no synchronized lyrics.
+func (d *Decoder) SyncedLyrics() []SyncedLyrics {
+	if d.source.id3v2 == nil {
+		return nil
+	}
+	tag, err := id3.Parse(d.source.id3v2)
+	if err != nil {
+		return nil
+	}
+
+	var lyrics []SyncedLyrics
+	for _, f := range tag.Frames {
+		if id3.CanonicalID(f.ID, tag.MajorVersion) != "SYLT" {
+			continue
+		}
+		s, err := id3.ParseSyncedText(f.Data)
+		if err != nil {
+			continue
+		}
+		l := SyncedLyrics{
+			Language:    s.Language,
+			Description: s.Description,
+			ContentType: s.ContentType,
+		}
+		for _, e := range s.Entries {
+			l.Lines = append(l.Lines, LyricLine{
+				Time: d.syncedTextTime(s.TimestampFormat, e.Timestamp),
+				Text: e.Text,
+			})
+		}
+		sort.SliceStable(l.Lines, func(i, j int) bool {
+			return l.Lines[i].Time < l.Lines[j].Time
+		})
+		lyrics = append(lyrics, l)
+	}
+	return lyrics
+}
+
+// syncedTextTime converts the timestamp of a SYLT frame in the format to the time.
+func (d *Decoder) syncedTextTime(format int, timestamp uint32) time.Duration {
+	const (
+		formatMPEGFrames   = 1
+		formatMilliseconds = 2
+	)
+	if format == formatMPEGFrames && d.frame != nil && d.sampleRate > 0 {
+		samples := int64(timestamp) * int64(d.frame.Header().BytesPerFrame()/4)
+		return time.Duration(samples) * time.Second / time.Duration(d.sampleRate)
+	}
+	return time.Duration(timestamp) * time.Millisecond
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// syltFrame returns a SYLT frame in UTF-8 with the timestamp format and the lines.
+func syltFrame(format byte, lines ...interface{}) []byte {
+	b := []byte{3}
+	b = append(b, "eng"...)
+	b = append(b, format, 1)
+	b = append(b, "desc\x00"...)
+	for i := 0; i < len(lines); i += 2 {
+		b = append(b, lines[i].(string)...)
+		b = append(b, 0)
+		var ts [4]byte
+		binary.BigEndian.PutUint32(ts[:], lines[i+1].(uint32))
+		b = append(b, ts[:]...)
+	}
+	return id3v24Frame("SYLT", b)
+}
+
+func TestLyrics(t *testing.T) {
+	tag := id3v24Tag(
+		id3v24Frame("USLT", append(textFrame("eng"), "\x00First line\nSecond line"...)),
+		syltFrame(2, "Second", uint32(2000), "First", uint32(500)),
+		// 100 MPEG frames of MPEG-2 at 22050 Hz are 100*576/22050 seconds.
+		syltFrame(1, "Frame", uint32(100)),
+	)
+	d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, tag)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantLyrics := []mp3.Lyrics{
+		{Language: "eng", Text: "First line\nSecond line"},
+	}
+	if got := d.Lyrics(); !reflect.DeepEqual(got, wantLyrics) {
+		t.Errorf("Lyrics: got: %+v, want: %+v", got, wantLyrics)
+	}
+
+	wantSynced := []mp3.SyncedLyrics{
+		{
+			Language:    "eng",
+			Description: "desc",
+			ContentType: 1,
+			Lines: []mp3.LyricLine{
+				{Time: 500 * time.Millisecond, Text: "First"},
+				{Time: 2000 * time.Millisecond, Text: "Second"},
+			},
+		},
+		{
+			Language:    "eng",
+			Description: "desc",
+			ContentType: 1,
+			Lines: []mp3.LyricLine{
+				{Time: 100 * 576 * time.Second / 22050, Text: "Frame"},
+			},
+		},
+	}
+	synced := d.SyncedLyrics()
+	if !reflect.DeepEqual(synced, wantSynced) {
+		t.Fatalf("SyncedLyrics: got: %+v, want: %+v", synced, wantSynced)
+	}
+
+	for _, tc := range []struct {
+		t    time.Duration
+		want int
+	}{
+		{0, -1},
+		{500 * time.Millisecond, 0},
+		{time.Second, 0},
+		{3 * time.Second, 1},
+	} {
+		if got := synced[0].LineAt(tc.t); got != tc.want {
+			t.Errorf("LineAt(%v): got: %d, want: %d", tc.t, got, tc.want)
+		}
+	}
+}
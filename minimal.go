@@ -0,0 +1,63 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build mp3minimal
+// +build mp3minimal
+
+package mp3
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+// The mp3minimal build tag excludes the features that are not needed to decode a stream, like RingBuffer,
+// NewSparseDecoder and the loudness normalization, so that the binary size stays small.
+// This file provides the no-op counterparts that the decoder core refers to.
+
+type loudnessNormalizer struct {
+	meter *struct{}
+}
+
+func (n *loudnessNormalizer) process(pcm []byte) {
+}
+
+func (d *Decoder) setUpLoudnessNormalization(measure bool) error {
+	return errors.New("mp3: NormalizeLoudness is not available with the mp3minimal build tag")
+}
+
+func (d *Decoder) setUpReplayGain(mode ReplayGainMode, preamp float64) error {
+	return errors.New("mp3: ReplayGain is not available with the mp3minimal build tag")
+}
+
+type icyReader struct{}
+
+func (d *Decoder) setUpICY(r io.Reader, metaInt int, onStreamTitle func(title string, position time.Duration)) (io.Reader, error) {
+	return nil, errors.New("mp3: ICYMetaInt is not available with the mp3minimal build tag")
+}
+
+func (d *Decoder) notifyStreamTitles(offset int64) {
+}
+
+func (d *Decoder) notifyFrameChecksum(offset int64, pcm []byte) {
+}
+
+func (d *Decoder) handleGaps(pcm []byte) []byte {
+	return pcm
+}
+
+func (d *Decoder) readSilentFrame() error {
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+	"math"
+
+	"github.com/hajimehoshi/go-mp3/loudness"
+)
+
+// TargetLoudness is the integrated loudness in LUFS that NormalizeLoudness targets.
+const TargetLoudness = -16
+
+// maxNormalizationGain is the maximum absolute gain in dB applied by the loudness normalization.
+const maxNormalizationGain = 20
+
+type loudnessNormalizer struct {
+	// meter is non-nil when the gain follows the running estimate of the loudness.
+	meter *loudness.Meter
+	gain  float64
+}
+
+func normalizationGain(lufs float64) float64 {
+	db := TargetLoudness - lufs
+	if db > maxNormalizationGain {
+		db = maxNormalizationGain
+	}
+	if db < -maxNormalizationGain {
+		db = -maxNormalizationGain
+	}
+	return math.Pow(10, db/20)
+}
+
+func (n *loudnessNormalizer) process(pcm []byte) {
+	from := n.gain
+	to := n.gain
+	if n.meter != nil {
+		n.meter.AddInt16(pcm)
+		if l, ok := n.meter.Integrated(); ok {
+			to = normalizationGain(l)
+		}
+	}
+
+	// Ramp the gain linearly over the frame to avoid clicks.
+	samples := len(pcm) / 4
+	for i := 0; i < samples; i++ {
+		g := from + (to-from)*float64(i)/float64(samples)
+		for ch := 0; ch < 2; ch++ {
+			idx := 4*i + 2*ch
+			v := float64(int16(uint16(pcm[idx])|uint16(pcm[idx+1])<<8)) * g
+			if v > 32767 {
+				v = 32767
+			} else if v < -32767 {
+				v = -32767
+			}
+			s := int16(v)
+			pcm[idx] = byte(s)
+			pcm[idx+1] = byte(s >> 8)
+		}
+	}
+	n.gain = to
+}
+
+// setUpLoudnessNormalization sets up the loudness normalization.
+//
+// If measure is true, setUpLoudnessNormalization measures the whole stream first and applies a constant gain.
+// Otherwise, the gain follows the running estimate of the loudness.
+func (d *Decoder) setUpLoudnessNormalization(measure bool) error {
+	if !measure {
+		d.normalizer = &loudnessNormalizer{
+			meter: loudness.NewMeter(d.sampleRate),
+			gain:  1,
+		}
+		return nil
+	}
+
+	m := loudness.NewMeter(d.sampleRate)
+	if _, err := d.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	buf := make([]byte, 4096)
+	for {
+		n, err := d.read(buf)
+		m.AddInt16(buf[:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	gain := 1.0
+	if l, ok := m.Integrated(); ok {
+		gain = normalizationGain(l)
+	}
+	d.normalizer = &loudnessNormalizer{
+		gain: gain,
+	}
+	if _, err := d.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return nil
+}
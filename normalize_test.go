@@ -0,0 +1,99 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestNormalizeLoudnessFramesPerRead(t *testing.T) {
+	// Use MPEG-1 frames, which are decoded to 4608 bytes, so that a buffer for a frame is large.
+	var src bytes.Buffer
+	if err := mp3.Cut(&src, bytes.NewReader(mustReadFile(t, "example/classic.mp3")), 0, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src.Bytes()), &mp3.DecoderOptions{NormalizeLoudness: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err = mp3.NewDecoderWithOptions(bytes.NewReader(src.Bytes()), &mp3.DecoderOptions{
+		NormalizeLoudness: true,
+		FramesPerRead:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []byte
+	buf := make([]byte, 4608)
+	for {
+		n, err := d.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("normalized PCM with FramesPerRead doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
+
+func TestNormalizeLoudnessBackgroundScan(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	// The gain follows the running estimate of the loudness when the length is unknown.
+	d, err := mp3.NewDecoderWithOptions(struct{ io.Reader }{bytes.NewReader(src)}, &mp3.DecoderOptions{NormalizeLoudness: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same applies with BackgroundScan, where the length is being scanned on another goroutine.
+	d, err = mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		NormalizeLoudness: true,
+		BackgroundScan:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("normalized PCM with BackgroundScan doesn't match: got: %d bytes, want: %d bytes", len(got), len(want))
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"time"
+)
+
+// A ByteRange represents a range of bytes [Offset, Offset+Length) in a source.
+type ByteRange struct {
+	Offset int64
+	Length int64
+}
+
+// SeekMode represents how Decoder's Seek positions the stream.
+type SeekMode int
+
+const (
+	// SeekModeAccurate seeks to the exact requested sample.
+	// The frames before the target frame are decoded to prime the decoder, and the target frame is decoded and
+	// trimmed.
+	SeekModeAccurate SeekMode = iota
+
+	// SeekModeFast seeks to the start of the frame that contains the requested offset.
+	// Seek returns the frame-aligned offset. No PCM is synthesized while seeking, so the first frame after a seek
+	// might not be perfectly smooth.
+	SeekModeFast
+)
+
+// Quality represents the trade-off between the quality and the speed of decoding.
+type Quality int
+
+const (
+	// QualityAccurate decodes the whole frequency band as the standard specifies.
+	QualityAccurate Quality = iota
+
+	// QualityFast decodes only the lower half of the frequency band, which is up to a quarter of the sample rate,
+	// and skips the alias reduction. The higher frequencies are lost, but the decoding takes much less CPU time.
+	// This is useful for preview scrubbing, waveform generation and battery-constrained devices.
+	QualityFast
+)
+
+// ParameterChangePolicy represents how Decoder handles a change of the stream parameters in the middle of a
+// stream, which happens with concatenated streams like dumps of Internet radio.
+//
+// The stream parameters are the MPEG version, the sample rate and the number of channels.
+// A change of the bitrate is not a parameter change as it is usual for VBR streams.
+type ParameterChangePolicy int
+
+const (
+	// ParameterChangeContinue continues decoding the new segment.
+	// Note that the sample rate of the decoded stream changes in this case.
+	ParameterChangeContinue ParameterChangePolicy = iota
+
+	// ParameterChangeError makes Read return an error wrapping ErrParameterChange.
+	ParameterChangeError
+)
+
+// CRCMismatchPolicy represents how Decoder handles a frame whose CRC doesn't match.
+//
+// Frames without CRCs are not checked. A CRC mismatch is always counted in Stats.
+type CRCMismatchPolicy int
+
+const (
+	// CRCMismatchIgnore decodes the frame as usual.
+	CRCMismatchIgnore CRCMismatchPolicy = iota
+
+	// CRCMismatchConceal outputs silence instead of decoding the frame.
+	CRCMismatchConceal
+
+	// CRCMismatchError makes Read return an error wrapping ErrCRCMismatch.
+	// The frame is skipped and the following Read continues with the next frame.
+	CRCMismatchError
+)
+
+// FrameErrorPolicy represents how Decoder handles a frame that can't be decoded, e.g. a frame with corrupted side
+// information or Huffman data.
+type FrameErrorPolicy int
+
+const (
+	// FrameErrorAbort makes Read return the error.
+	FrameErrorAbort FrameErrorPolicy = iota
+
+	// FrameErrorSkip skips the frame. The decoder resynchronizes to the next valid frame header and continues.
+	// Note that the decoded stream becomes shorter than the length.
+	//
+	// When the source is io.Seeker, the decoder resynchronizes from the byte after the bad frame's header and
+	// requires the frame header to be followed by another frame header, so that the good frames following a frame
+	// with a corrupted header are not lost.
+	FrameErrorSkip
+
+	// FrameErrorSilence is like FrameErrorSkip but outputs silence instead of the frame so that the decoded stream
+	// keeps its length.
+	FrameErrorSilence
+
+	// FrameErrorRepeat is like FrameErrorSilence but outputs the PCM of the previous frame instead of the frame.
+	// When bad frames continue, each repetition is attenuated by 6 dB so that the output fades out.
+	FrameErrorRepeat
+)
+
+// ReplayGainMode represents which ReplayGain adjustment Decoder applies.
+type ReplayGainMode int
+
+const (
+	// ReplayGainOff doesn't apply ReplayGain.
+	ReplayGainOff ReplayGainMode = iota
+
+	// ReplayGainTrack applies the track gain.
+	ReplayGainTrack
+
+	// ReplayGainAlbum applies the album gain. The track gain is applied instead if the album gain is not available.
+	ReplayGainAlbum
+)
+
+// MemoryLimit represents hard limits of the memory a Decoder uses, e.g. for untrusted input.
+// A zero value in each field means no limit.
+type MemoryLimit struct {
+	// MaxReservoirBytes is the maximum number of bytes of the previous frames' main data a frame can refer back to.
+	// A frame referring back to more bytes is a bad frame wrapping ErrMemoryLimit.
+	// The bit reservoir needs up to 511 bytes in valid streams.
+	MaxReservoirBytes int
+
+	// MaxBufferedBytes is the maximum number of bytes of decoded PCM buffered in a Decoder.
+	// Read returns an error wrapping ErrMemoryLimit when the limit is exceeded.
+	// A frame is decoded to up to 4608 bytes.
+	MaxBufferedBytes int
+
+	// MaxIndexEntries is the maximum number of frames in the index made by scanning the stream.
+	// The scan fails with an error wrapping ErrMemoryLimit when the stream has more frames.
+	// A frame index entry takes about 2 bytes.
+	MaxIndexEntries int
+}
+
+// DecoderOptions represents options for NewDecoderWithOptions.
+type DecoderOptions struct {
+	// SeekMode specifies how Seek positions the stream.
+	//
+	// The default (zero) value is SeekModeAccurate.
+	SeekMode SeekMode
+
+	// Quality specifies the trade-off between the quality and the speed of decoding.
+	//
+	// The default (zero) value is QualityAccurate.
+	Quality Quality
+
+	// FramesPerRead specifies the number of the frames whose PCM each Read returns at once. When FramesPerRead is
+	// positive, Read never splits the PCM of a frame: Read returns the PCM of FramesPerRead frames, or as many whole
+	// frames as buf can hold. Read returns fewer frames at the end of the stream, and returns io.ErrShortBuffer when
+	// buf can't hold even one frame. A frame is decoded to up to 4608 bytes. The frames trimmed for gapless playback
+	// or seeking are shorter, and the silence for a gap of NewSparseDecoder counts as a frame.
+	//
+	// When an error happens after some frames are read, Read returns the PCM without the error and the next Read
+	// returns the error.
+	//
+	// The default (zero) value means Read fills buf regardless of the frame boundaries.
+	FramesPerRead int
+
+	// ScanProgress is called periodically while the stream is scanned to calculate the length.
+	// The scan happens in NewDecoderWithOptions when the source is an io.Seeker.
+	// With BackgroundScan, ScanProgress is called on another goroutine.
+	//
+	// bytes is the number of bytes scanned so far and frames is the number of frames found so far.
+	// ScanProgress is called at least once when the scan finishes.
+	//
+	// If ScanProgress returns a non-nil error, the scan is aborted and NewDecoderWithOptions returns the error.
+	// With BackgroundScan, Seek returns the error instead.
+	ScanProgress func(bytes int64, frames int) error
+
+	// BackgroundScan specifies whether the length scan runs on another goroutine while decoding starts immediately.
+	//
+	// BackgroundScan works only when the source is both io.ReaderAt and io.Seeker, like *os.File or *bytes.Reader.
+	// The scan reads the source via ReadAt so that it doesn't disturb the decoding position.
+	// Otherwise, BackgroundScan is ignored and the scan finishes in NewDecoderWithOptions.
+	//
+	// Length returns -1 until the scan finishes. Seek waits for the scan to finish.
+	//
+	// The scan goroutine keeps reading the source until the scan finishes, even if the decoder is no longer used.
+	// Call Decoder.Close, or cancel the context of NewDecoderContext, to stop the scan early.
+	BackgroundScan bool
+
+	// NormalizeLoudness specifies whether the decoded stream is normalized to TargetLoudness (-16 LUFS)
+	// measured by ITU-R BS.1770.
+	//
+	// When the length of the stream is known, the whole stream is measured in NewDecoderWithOptions first, and a
+	// constant gain is applied. Note that this decodes the stream twice.
+	// Otherwise, e.g. when the source is not io.Seeker or BackgroundScan is specified, the gain follows the running
+	// estimate of the loudness of the stream decoded so far.
+	NormalizeLoudness bool
+
+	// ReplayGain specifies which ReplayGain adjustment of the stream is applied for volume-normalized playback.
+	// The ReplayGain values are read from the tags and the LAME tag. See Decoder.ReplayGain.
+	//
+	// The gain is reduced so that the peak doesn't clip when the peak is known. Nothing is applied when the stream
+	// doesn't have the gain. ReplayGain can't be used with NormalizeLoudness.
+	//
+	// The default (zero) value is ReplayGainOff.
+	ReplayGain ReplayGainMode
+
+	// ReplayGainPreamp is the gain in dB added to the ReplayGain adjustment, e.g. to compensate for the reference
+	// level of 89 dB SPL.
+	ReplayGainPreamp float64
+
+	// ParameterChange specifies how a change of the stream parameters in the middle of the stream is handled.
+	//
+	// The default (zero) value is ParameterChangeContinue.
+	ParameterChange ParameterChangePolicy
+
+	// OnSegment is called when a new segment with different stream parameters starts in the middle of the stream.
+	// OnSegment is called with the information of the new segment on the goroutine calling Read.
+	// Only SampleRate and Channels of info are valid. The other fields are -1 or empty.
+	OnSegment func(info StreamInfo)
+
+	// CRCMismatch specifies how a frame whose CRC doesn't match is handled.
+	//
+	// The default (zero) value is CRCMismatchIgnore.
+	CRCMismatch CRCMismatchPolicy
+
+	// FrameError specifies how a frame that can't be decoded is handled.
+	//
+	// The default (zero) value is FrameErrorAbort.
+	FrameError FrameErrorPolicy
+
+	// AcceptReservedEmphasis specifies whether frames with the reserved emphasis value 2 are decoded.
+	// Some encoders emit such frames. By default, such frame headers are invalid and the decoder skips the bytes to
+	// find the next valid header.
+	//
+	// Emphasis is not applied by the decoder in any case, so such frames are decoded as if emphasis is none.
+	AcceptReservedEmphasis bool
+
+	// MaxResyncBytes is the maximum number of bytes skipped to find the next valid frame header, e.g. in garbage
+	// data. When the limit is exceeded, the error wrapping ErrNoSync is returned. This protects services from
+	// scanning huge non-MP3 inputs.
+	//
+	// ID3v2 tags are skipped wholesale and are not counted.
+	// The default (zero) value means no limit.
+	MaxResyncBytes int
+
+	// SyncConfirmations is the number of the following frame headers that must be valid and consistent, e.g. in
+	// the sampling frequency, to accept a frame header found by skipping bytes. A 4-byte pattern alone is a weak
+	// criterion, and a false sync in garbage like broken tags produces a garbage frame. The following frame
+	// headers are checked at the offsets computed from the frame sizes.
+	//
+	// A frame header at the position where the previous frame ends is accepted by itself.
+	// The default (zero) value means no confirmation.
+	SyncConfirmations int
+
+	// Strict specifies whether any data loss is an error, which makes the decoder a conformance checker.
+	//
+	// In the strict mode, Read returns an error wrapping ErrResync when bytes other than tags are skipped to find a
+	// frame header, ErrCRCMismatch when the CRC of a frame doesn't match regardless of CRCMismatch, and
+	// ErrReservoirUnderflow when the bit reservoir is insufficient. FrameError is ignored and any frame error is
+	// returned.
+	Strict bool
+
+	// PartialFinalFrame specifies whether a frame cut at the end of the stream is decoded as far as available
+	// instead of being dropped. The missing frequency lines are decoded as zero. This is useful for progressive
+	// download where the stream is decoded while it is being downloaded.
+	//
+	// A frame is decoded partially only when its header and side information are available.
+	// The truncation is still reported by Err and OnWarning.
+	PartialFinalFrame bool
+
+	// OnWarning is called on the goroutine calling Read when a non-fatal condition happens, e.g. bytes are skipped
+	// or a frame is concealed. Such conditions are silently handled without OnWarning.
+	// A truncation found by the scan to calculate the length is reported in NewDecoderWithOptions. With
+	// BackgroundScan, it is reported by Read or Seek after the scan finishes.
+	OnWarning func(w Warning)
+
+	// MemoryLimit specifies the limits of the memory the decoder uses.
+	MemoryLimit MemoryLimit
+
+	// OnCRCError is called on the goroutine calling Read when the CRC of a frame doesn't match, regardless of
+	// CRCMismatch. offset is the byte offset of the frame in the source.
+	OnCRCError func(offset int64)
+
+	// OnResync is called on the goroutine calling Read when bytes are skipped to find the next frame header.
+	// Tags embedded in the stream are also counted in skippedBytes.
+	OnResync func(skippedBytes int64)
+
+	// OnFrameChecksum is called on the goroutine calling Read with the CRC-32 (IEEE) checksum of the PCM of each
+	// frame. offset is the byte offset of the frame in the source. The PCM is the output for the frame before the
+	// samples are trimmed for gapless playback, including silence for concealed frames and missing frames.
+	//
+	// The decoder's output is deterministic: the same input and the same options produce bit-identical PCM on all
+	// platforms and architectures. The floating-point operations are rounded explicitly so that they are not fused
+	// into multiply-add instructions, and the gains are computed exactly without math.Pow. Thus, the checksums can be
+	// used to verify the reproducibility, e.g. for caches of decoded PCM. NormalizeLoudness and ReplayGain are
+	// excluded from this guarantee as they depend on the math package's logarithm and power functions.
+	//
+	// OnFrameChecksum is not called with the mp3minimal build tag.
+	OnFrameChecksum func(offset int64, checksum uint32)
+
+	// ICYMetaInt is the interval of the ICY metadata blocks in bytes, which is the value of the icy-metaint HTTP
+	// response header of a Shoutcast or Icecast stream requested with the "Icy-MetaData: 1" header.
+	// When ICYMetaInt is positive, the metadata blocks interleaved in the source are removed before decoding.
+	//
+	// The default (zero) value means the source doesn't have ICY metadata.
+	ICYMetaInt int
+
+	// OnStreamTitle is called on the goroutine calling Read when StreamTitle in the ICY metadata changes, e.g. when
+	// the next song starts on an Internet radio. position is the time in the decoded stream where the title starts,
+	// which is the start of the first frame after the metadata block.
+	OnStreamTitle func(title string, position time.Duration)
+
+	// OnGap is called when a decoder created by NewSparseDecoder skips a missing range of the source.
+	OnGap func(r ByteRange)
+
+	// Scratch is the scratch memory the decoder uses, e.g. the memory of a decoder no longer used. See Scratch.
+	//
+	// The default (nil) value means the decoder allocates its own memory.
+	Scratch *Scratch
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+	"runtime"
+	"sync"
+)
+
+// DecodeParallel decodes the rest of the stream from the current position and returns the PCM, like
+// ioutil.ReadAll(d) does, by decoding ranges of the stream on up to workers goroutines.
+// If workers is 0 or less, runtime.GOMAXPROCS(0) is used.
+//
+// Each range is decoded by a clone of d (see Clone) that seeks to the range with SeekModeAccurate regardless of
+// DecoderOptions.SeekMode, so the preceding
+// frames are decoded to fill the bit reservoir and the synthesis state. The result is the same as decoding the stream
+// sequentially.
+//
+// DecodeParallel requires the source to be both io.ReaderAt and io.Seeker, and its ReadAt to be safe for concurrent
+// use. If the source is not, the loudness normalization is enabled, which depends on all the preceding frames, or
+// the stream has both MPEG-1 and MPEG-2 frames, the stream is decoded sequentially.
+//
+// The callbacks in DecoderOptions might be called concurrently and out of order.
+//
+// After DecodeParallel returns, d is at the end of the stream.
+func (d *Decoder) DecodeParallel(workers int) ([]byte, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	r, ok := d.source.reader.(interface {
+		io.ReaderAt
+		io.Seeker
+	})
+	if !ok || workers == 1 || d.normalizer != nil {
+		return d.DecodeAll()
+	}
+	if err := d.waitScan(); err != nil {
+		return nil, err
+	}
+	// The ranges are split by the frame size, which varies when the stream has MPEG-1 and MPEG-2 segments.
+	bytesPerFrame, ok := d.frameSizes.uniform()
+	if !ok {
+		return d.DecodeAll()
+	}
+
+	start, err := d.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	end := d.Length()
+	if start >= end {
+		return d.DecodeAll()
+	}
+
+	// The size of the source is got here once. sourceSize might seek the source, which the workers share.
+	streamSize, err := sourceSize(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the rest into ranges of whole frames.
+	frames := (end - start + bytesPerFrame - 1) / bytesPerFrame
+	if int64(workers) > frames {
+		workers = int(frames)
+	}
+	size := (frames + int64(workers) - 1) / int64(workers) * bytesPerFrame
+
+	out := make([]byte, end-start)
+	n := int64(len(out))
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		from := int64(i) * size
+		to := from + size
+		if to > int64(len(out)) {
+			to = int64(len(out))
+		}
+		if from >= to {
+			break
+		}
+		last := to == int64(len(out))
+		wg.Add(1)
+		go func(i int, from, to int64, last bool) {
+			defer wg.Done()
+			c, err := d.clone(r, streamSize)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			// With SeekModeFast, Seek would skip decoding the preceding frames and the result would differ from the
+			// sequential decoding.
+			c.seekMode = SeekModeAccurate
+			if _, err := c.Seek(start+from, io.SeekStart); err != nil {
+				errs[i] = err
+				return
+			}
+			read, err := io.ReadFull(c, out[from:to])
+			if last && (err == io.EOF || err == io.ErrUnexpectedEOF) {
+				// The decoded stream can be shorter than Length when the last frames are broken.
+				n = from + int64(read)
+				return
+			}
+			if err != nil {
+				errs[i] = err
+			}
+		}(i, from, to, last)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := d.Seek(0, io.SeekEnd); err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
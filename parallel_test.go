@@ -0,0 +1,97 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestDecodeParallel(t *testing.T) {
+	for _, c := range []struct {
+		path     string
+		workers  int
+		seekMode mp3.SeekMode
+	}{
+		{"example/classic.mp3", 0, mp3.SeekModeAccurate},
+		{"example/classic.mp3", 4, mp3.SeekModeAccurate},
+		{"example/classic.mp3", 4, mp3.SeekModeFast},
+		{"example/mpeg2.mp3", 7, mp3.SeekModeAccurate},
+		{"example/mpeg2.mp3", 7, mp3.SeekModeFast},
+	} {
+		want := decodeAll(t, c.path, nil)
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(mustReadFile(t, c.path)), &mp3.DecoderOptions{
+			SeekMode: c.seekMode,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := d.DecodeParallel(c.workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s, %d workers, seek mode %d: decoded PCM doesn't match the sequential decoding", c.path, c.workers, c.seekMode)
+		}
+		if n, err := d.Read(make([]byte, 4)); n != 0 || err != io.EOF {
+			t.Errorf("%s, %d workers, seek mode %d: Read after DecodeParallel: got: %d, %v, want: 0, EOF", c.path, c.workers, c.seekMode, n, err)
+		}
+	}
+
+	// DecodeParallel starts at the current position.
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const offset = 12345 * 4
+	if _, err := io.ReadFull(d, make([]byte, offset)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.DecodeParallel(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want[offset:]) {
+		t.Errorf("decoded PCM from the middle doesn't match the sequential decoding")
+	}
+}
+
+func TestDecodeParallelWithoutSize(t *testing.T) {
+	// The source doesn't have Size, so the size is got by seeking the source, which the workers share.
+	// Run this test with -race.
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	r := bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3"))
+	d, err := mp3.NewDecoder(struct {
+		io.ReadSeeker
+		io.ReaderAt
+	}{r, r})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.DecodeParallel(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("decoded PCM doesn't match the sequential decoding")
+	}
+}
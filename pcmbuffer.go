@@ -0,0 +1,82 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+// pcmBufferSize is the usual capacity of a pcmBuffer, which is enough for a few frames.
+const pcmBufferSize = 4 * maxBytesPerFrame
+
+// pcmBuffer is the decoded PCM that is not read yet.
+//
+// The memory is recycled: the bytes read at the beginning are reclaimed when appending, so the buffer doesn't grow
+// nor generate garbage while it is read. The memory grows only when more PCM is appended at once, e.g. the silence for
+// missing frames, and is released when the buffer is reset.
+type pcmBuffer struct {
+	mem []byte
+	r   int
+	w   int
+}
+
+// len returns the number of the bytes in the buffer.
+func (b *pcmBuffer) len() int {
+	return b.w - b.r
+}
+
+// bytes returns the bytes in the buffer. The returned slice is valid until the next append.
+func (b *pcmBuffer) bytes() []byte {
+	return b.mem[b.r:b.w]
+}
+
+// append appends pcm to the buffer.
+func (b *pcmBuffer) append(pcm []byte) {
+	if len(b.mem)-b.w < len(pcm) {
+		n := b.len()
+		if n+len(pcm) > len(b.mem) {
+			size := pcmBufferSize
+			for size < n+len(pcm) {
+				size *= 2
+			}
+			mem := make([]byte, size)
+			copy(mem, b.mem[b.r:b.w])
+			b.mem = mem
+		} else {
+			copy(b.mem, b.mem[b.r:b.w])
+		}
+		b.r = 0
+		b.w = n
+	}
+	b.w += copy(b.mem[b.w:], pcm)
+}
+
+// skip consumes n bytes at the beginning. n is clamped to the number of the bytes in the buffer.
+func (b *pcmBuffer) skip(n int) {
+	if n > b.len() {
+		n = b.len()
+	}
+	b.r += n
+	if b.r == b.w {
+		// Start over at the beginning so that the next append doesn't have to move the bytes.
+		b.r = 0
+		b.w = 0
+	}
+}
+
+// reset empties the buffer. The memory is released if it has grown beyond the usual capacity.
+func (b *pcmBuffer) reset() {
+	b.r = 0
+	b.w = 0
+	if len(b.mem) > pcmBufferSize {
+		b.mem = nil
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"testing"
+)
+
+func TestPCMBuffer(t *testing.T) {
+	var b pcmBuffer
+	frame := make([]byte, 4608)
+	read := make([]byte, 1000)
+	// The bytes are sequential numbers to check the order.
+	var next, expected byte
+	// Append frames and read them in smaller pieces as Read does.
+	step := func() {
+		for i := range frame {
+			frame[i] = next
+			next++
+		}
+		b.append(frame)
+		for b.len() >= len(read) {
+			n := copy(read, b.bytes())
+			for _, v := range read[:n] {
+				if v != expected {
+					t.Fatalf("got: %d, want: %d", v, expected)
+				}
+				expected++
+			}
+			b.skip(n)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		step()
+	}
+	if n := testing.AllocsPerRun(100, step); n != 0 {
+		t.Errorf("allocations: got: %v, want: 0", n)
+	}
+	if len(b.mem) != pcmBufferSize {
+		t.Errorf("capacity: got: %d, want: %d", len(b.mem), pcmBufferSize)
+	}
+
+	// The buffer grows for a large append, and the memory is released by reset.
+	rest := b.len()
+	b.append(make([]byte, 10*pcmBufferSize))
+	if got, want := b.len(), rest+10*pcmBufferSize; got != want {
+		t.Errorf("len: got: %d, want: %d", got, want)
+	}
+	// Skipping more than the buffer has empties the buffer.
+	b.skip(b.len() + 100)
+	if got := b.len(); got != 0 {
+		t.Errorf("len after skipping too many bytes: got: %d, want: 0", got)
+	}
+	b.append(frame)
+	if got, want := b.len(), len(frame); got != want {
+		t.Errorf("len after skipping too many bytes and appending: got: %d, want: %d", got, want)
+	}
+	b.reset()
+	if b.len() != 0 || b.mem != nil {
+		t.Errorf("reset: got: len %d, memory %d bytes, want: len 0, no memory", b.len(), len(b.mem))
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/hajimehoshi/go-mp3/resample"
+	"github.com/hajimehoshi/go-mp3/wav"
+)
+
+// PCMFormat represents the format of 16-bit little-endian linear PCM in a Pipeline.
+type PCMFormat struct {
+	SampleRate int
+	Channels   int
+}
+
+// A Processor is a stage of a Pipeline.
+type Processor interface {
+	// Process returns a reader of the PCM read from r and processed, and the format of the processed PCM.
+	// format is the format of the PCM of r.
+	Process(r io.Reader, format PCMFormat) (io.Reader, PCMFormat, error)
+}
+
+// PipelineOptions represents options for a Pipeline.
+type PipelineOptions struct {
+	// Processors are the stages applied to the decoded PCM in order.
+	Processors []Processor
+
+	// Progress is called every time the decoded PCM is read by the processors.
+	//
+	// done is the position in the decoded stream in bytes, and total is the length of the decoded stream in bytes
+	// as Decoder's Length, or -1 if unknown.
+	//
+	// If Progress returns a non-nil error, the pipeline is aborted with the error.
+	Progress func(done, total int64) error
+}
+
+// A Pipeline processes the PCM of a Decoder by processors like NewGainProcessor, NewResampleProcessor and
+// NewDownmixProcessor, and provides the processed PCM.
+//
+// A Pipeline pulls the PCM from the decoder through the processors as the processed PCM is read, so a slow sink
+// slows down decoding without buffering the stream in memory.
+type Pipeline struct {
+	r      io.Reader
+	format PCMFormat
+}
+
+// NewPipeline returns a new Pipeline for the rest of the stream of d from the current position.
+//
+// options can be nil. A nil options is the same as the default options, which has no processors.
+func NewPipeline(d *Decoder, options *PipelineOptions) (*Pipeline, error) {
+	if options == nil {
+		options = &PipelineOptions{}
+	}
+	var r io.Reader = d
+	if options.Progress != nil {
+		r = &progressReader{
+			d:        d,
+			progress: options.Progress,
+		}
+	}
+	// The decoded PCM is always stereo.
+	format := PCMFormat{
+		SampleRate: d.SampleRate(),
+		Channels:   2,
+	}
+	for _, p := range options.Processors {
+		var err error
+		r, format, err = p.Process(r, format)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Pipeline{
+		r:      r,
+		format: format,
+	}, nil
+}
+
+// Format returns the format of the processed PCM.
+func (p *Pipeline) Format() PCMFormat {
+	return p.format
+}
+
+// Read reads the processed PCM.
+func (p *Pipeline) Read(buf []byte) (int, error) {
+	return p.r.Read(buf)
+}
+
+// WriteTo writes the processed PCM to w until the end of the stream.
+func (p *Pipeline) WriteTo(w io.Writer) (int64, error) {
+	return io.CopyBuffer(w, struct{ io.Reader }{p.r}, make([]byte, writeToBufferSize))
+}
+
+// WriteWAV writes the processed PCM to w as a WAV file.
+//
+// The size of the PCM is not known in advance. When w is an io.WriteSeeker, the header is updated at the end.
+// Otherwise, the header has the maximum sizes.
+func (p *Pipeline) WriteWAV(w io.Writer) error {
+	ww, err := wav.NewWriter(w, p.format.SampleRate, p.format.Channels, wav.UnknownSize)
+	if err != nil {
+		return err
+	}
+	if _, err := p.WriteTo(ww); err != nil {
+		return err
+	}
+	return ww.Close()
+}
+
+// progressReader reads a Decoder and reports the progress.
+type progressReader struct {
+	d        *Decoder
+	progress func(done, total int64) error
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.d.Read(buf)
+	if n > 0 {
+		if perr := p.progress(p.d.outputPosition(p.d.pos), p.d.Length()); perr != nil {
+			return n, perr
+		}
+	}
+	return n, err
+}
+
+// blockReader reads PCM from a source and processes the PCM by blocks like samples.
+type blockReader struct {
+	src   io.Reader
+	block int
+
+	// process appends the processed PCM of src, whose size is a multiple of block, to dst and returns the result.
+	process func(dst, src []byte) []byte
+
+	in   []byte
+	rest int
+	buf  []byte
+	out  []byte
+	err  error
+}
+
+func newBlockReader(src io.Reader, block int, process func(dst, src []byte) []byte) *blockReader {
+	return &blockReader{
+		src:     src,
+		block:   block,
+		process: process,
+		in:      make([]byte, writeToBufferSize),
+	}
+}
+
+func (b *blockReader) Read(buf []byte) (int, error) {
+	for len(b.out) == 0 {
+		if b.err != nil {
+			return 0, b.err
+		}
+		n, err := b.src.Read(b.in[b.rest:])
+		n += b.rest
+		aligned := n - n%b.block
+		b.buf = b.process(b.buf[:0], b.in[:aligned])
+		b.out = b.buf
+		b.rest = copy(b.in, b.in[aligned:n])
+		b.err = err
+	}
+	n := copy(buf, b.out)
+	b.out = b.out[n:]
+	return n, nil
+}
+
+type gainProcessor struct {
+	gain float64
+}
+
+// NewGainProcessor returns a Processor that changes the volume by the gain in dB.
+// The samples are clipped to the range of 16-bit values.
+func NewGainProcessor(db float64) Processor {
+	return &gainProcessor{
+		gain: math.Pow(10, db/20),
+	}
+}
+
+func (g *gainProcessor) Process(r io.Reader, format PCMFormat) (io.Reader, PCMFormat, error) {
+	return newBlockReader(r, 2, func(dst, src []byte) []byte {
+		for i := 0; i < len(src); i += 2 {
+			v := math.Round(float64(int16(uint16(src[i])|uint16(src[i+1])<<8)) * g.gain)
+			if v > 32767 {
+				v = 32767
+			} else if v < -32768 {
+				v = -32768
+			}
+			s := int16(v)
+			dst = append(dst, byte(s), byte(s>>8))
+		}
+		return dst
+	}), format, nil
+}
+
+type resampleProcessor struct {
+	sampleRate int
+}
+
+// NewResampleProcessor returns a Processor that converts the sample rate by the resample package.
+// The PCM must be stereo, so resampling must precede downmixing.
+func NewResampleProcessor(sampleRate int) Processor {
+	return &resampleProcessor{
+		sampleRate: sampleRate,
+	}
+}
+
+func (p *resampleProcessor) Process(r io.Reader, format PCMFormat) (io.Reader, PCMFormat, error) {
+	if format.Channels != 2 {
+		return nil, PCMFormat{}, fmt.Errorf("mp3: resampling needs stereo PCM but got %d channels", format.Channels)
+	}
+	rr, err := resample.NewReader(r, format.SampleRate, p.sampleRate)
+	if err != nil {
+		return nil, PCMFormat{}, err
+	}
+	format.SampleRate = p.sampleRate
+	return rr, format, nil
+}
+
+type downmixProcessor struct{}
+
+// NewDownmixProcessor returns a Processor that mixes stereo PCM down to mono by averaging the two channels.
+func NewDownmixProcessor() Processor {
+	return downmixProcessor{}
+}
+
+func (downmixProcessor) Process(r io.Reader, format PCMFormat) (io.Reader, PCMFormat, error) {
+	if format.Channels == 1 {
+		return r, format, nil
+	}
+	if format.Channels != 2 {
+		return nil, PCMFormat{}, errors.New("mp3: downmixing needs stereo PCM")
+	}
+	format.Channels = 1
+	return newBlockReader(r, 4, func(dst, src []byte) []byte {
+		for i := 0; i < len(src); i += 4 {
+			l := int(int16(uint16(src[i]) | uint16(src[i+1])<<8))
+			r := int(int16(uint16(src[i+2]) | uint16(src[i+3])<<8))
+			// Round half away from zero.
+			sum := l + r
+			if sum >= 0 {
+				sum++
+			} else {
+				sum--
+			}
+			s := int16(sum / 2)
+			dst = append(dst, byte(s), byte(s>>8))
+		}
+		return dst
+	}), format, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func newPipeline(t *testing.T, options *mp3.PipelineOptions) *mp3.Pipeline {
+	t.Helper()
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := mp3.NewPipeline(d, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPipeline(t *testing.T) {
+	pcm := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	got, err := ioutil.ReadAll(newPipeline(t, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pcm) {
+		t.Errorf("the PCM without processors doesn't match")
+	}
+
+	p := newPipeline(t, &mp3.PipelineOptions{
+		Processors: []mp3.Processor{
+			mp3.NewGainProcessor(-6),
+			mp3.NewDownmixProcessor(),
+		},
+	})
+	if got, want := p.Format(), (mp3.PCMFormat{SampleRate: 22050, Channels: 1}); got != want {
+		t.Errorf("Format: got: %v, want: %v", got, want)
+	}
+	got, err = ioutil.ReadAll(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(pcm)/2 {
+		t.Fatalf("length: got: %d, want: %d", len(got), len(pcm)/2)
+	}
+	gain := math.Pow(10, -6.0/20)
+	for i := 0; i < len(got)/2; i++ {
+		l := math.Round(float64(int16(binary.LittleEndian.Uint16(pcm[4*i:]))) * gain)
+		r := math.Round(float64(int16(binary.LittleEndian.Uint16(pcm[4*i+2:]))) * gain)
+		if got, want := float64(int16(binary.LittleEndian.Uint16(got[2*i:]))), (l+r)/2; math.Abs(got-want) > 0.5 {
+			t.Fatalf("sample %d: got: %f, want: %f", i, got, want)
+		}
+	}
+}
+
+func TestPipelineWAV(t *testing.T) {
+	var calls int
+	var done, total int64
+	p := newPipeline(t, &mp3.PipelineOptions{
+		Processors: []mp3.Processor{
+			mp3.NewResampleProcessor(48000),
+			mp3.NewDownmixProcessor(),
+		},
+		Progress: func(d, t int64) error {
+			calls++
+			done, total = d, t
+			return nil
+		},
+	})
+	out := &bytes.Buffer{}
+	if err := p.WriteWAV(out); err != nil {
+		t.Fatal(err)
+	}
+	wav := out.Bytes()
+	if got, want := binary.LittleEndian.Uint32(wav[24:]), uint32(48000); got != want {
+		t.Errorf("sample rate: got: %d, want: %d", got, want)
+	}
+	if got, want := binary.LittleEndian.Uint16(wav[22:]), uint16(1); got != want {
+		t.Errorf("channels: got: %d, want: %d", got, want)
+	}
+	samples := len(decodeAll(t, "example/mpeg2.mp3", nil)) / 4
+	if got, want := len(wav)-44, (samples*48000+22049)/22050*2; got != want {
+		t.Errorf("data size: got: %d, want: %d", got, want)
+	}
+	if calls == 0 || done != total || total != int64(samples*4) {
+		t.Errorf("progress: %d calls, done: %d, total: %d", calls, done, total)
+	}
+}
+
+func TestPipelineErrors(t *testing.T) {
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Resampling after downmixing is not supported.
+	if _, err := mp3.NewPipeline(d, &mp3.PipelineOptions{
+		Processors: []mp3.Processor{
+			mp3.NewDownmixProcessor(),
+			mp3.NewResampleProcessor(48000),
+		},
+	}); err == nil {
+		t.Errorf("NewPipeline must fail")
+	}
+
+	// An error from Progress aborts the pipeline.
+	errAbort := errors.New("abort")
+	p := newPipeline(t, &mp3.PipelineOptions{
+		Progress: func(done, total int64) error {
+			if done > total/2 {
+				return errAbort
+			}
+			return nil
+		},
+	})
+	if _, err := p.WriteTo(ioutil.Discard); err != errAbort {
+		t.Errorf("WriteTo: got: %v, want: %v", err, errAbort)
+	}
+}
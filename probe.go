@@ -0,0 +1,125 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+)
+
+// ProbeResult represents the result of ProbeDuration.
+type ProbeResult struct {
+	// Duration is the duration of the stream, which is the same as Decoder's Duration when the stream has a Xing,
+	// Info or VBRI header with the number of frames.
+	Duration time.Duration
+
+	// SampleRate is the sample rate like 44100.
+	SampleRate int
+
+	// Channels is the number of channels of the source stream.
+	Channels int
+
+	// Estimated reports whether Duration is estimated from the size of the audio data and the bitrate of the first
+	// frame as the stream doesn't have the number of frames. The estimation is exact only for CBR streams.
+	Estimated bool
+}
+
+// ProbeDuration returns the duration, the sample rate and the number of channels of the stream r of size bytes
+// without decoding or scanning the stream, e.g. for indexing many files.
+//
+// ProbeDuration reads the ID3v2 tag headers at the beginning, the first frame and, only when the first frame doesn't
+// have a Xing, Info or VBRI header with the number of frames, the tags at the end to know the size of the audio
+// data. The contents of the ID3v2 tags are skipped without being read.
+func ProbeDuration(r io.ReaderAt, size int64) (*ProbeResult, error) {
+	t := tagReader{r: r}
+	start := int64(0)
+	for size-start >= id3v2HeaderSize {
+		h, err := t.readAt(start, id3v2HeaderSize)
+		if err != nil {
+			return nil, err
+		}
+		n, ok := id3v2TagSize(h)
+		if !ok || start+n > size {
+			break
+		}
+		start += n
+	}
+
+	s := &source{
+		reader: io.NewSectionReader(r, start, size-start),
+	}
+	var firstHeader frameheader.FrameHeader
+	if b, err := s.peek(4); err == nil && len(b) == 4 {
+		firstHeader = frameheader.FrameHeader(binary.BigEndian.Uint32(b))
+	}
+	info, first, err := readStreamInfo(s)
+	if err != nil {
+		return nil, noValidFramesError(err, firstHeader, start, start+s.pos)
+	}
+	result := &ProbeResult{
+		SampleRate: info.SampleRate,
+		Channels:   info.Channels,
+	}
+	spf := first.bytesPerFrame / 4
+
+	var samples int64
+	if info.Frames >= 0 {
+		samples = info.Frames * spf
+	} else {
+		// Estimate the duration from the bitrate of the first audio frame.
+		audioStart := start + first.start
+		if first.metadata {
+			audioStart = start + s.pos
+		}
+		b, err := t.readAt(audioStart, 4)
+		if err != nil {
+			return nil, err
+		}
+		h := frameheader.FrameHeader(binary.BigEndian.Uint32(b))
+		if !h.IsValid() || h.BitrateIndex() == 0 {
+			// No audio frame directly follows the metadata frame. Use the bitrate of the metadata frame instead.
+			b, err = t.readAt(start+first.start, 4)
+			if err != nil {
+				return nil, err
+			}
+			h = frameheader.FrameHeader(binary.BigEndian.Uint32(b))
+		}
+		_, end, err := t.endTags(audioStart, size)
+		if err != nil {
+			return nil, err
+		}
+		// A frame of spf samples has spf*bitrate/8/sampleRate bytes on average.
+		if bitrate := int64(h.Bitrate()); bitrate > 0 {
+			samples = (end - audioStart) * 8 * int64(info.SampleRate) / bitrate
+		}
+		result.Estimated = true
+	}
+
+	if l := info.LAME; l != nil && (l.EncoderDelay != 0 || l.EncoderPadding != 0) {
+		samples -= int64(l.EncoderDelay + l.EncoderPadding)
+		if samples < 0 {
+			samples = 0
+		}
+	}
+	rate := int64(info.SampleRate)
+	result.Duration = time.Duration(samples/rate)*time.Second + time.Duration(samples%rate)*time.Second/time.Duration(rate)
+	return result, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestProbeDuration(t *testing.T) {
+	// An ID3v1 tag at the end is not counted as audio data.
+	id3v1 := make([]byte, 128)
+	copy(id3v1, "TAG")
+	plain := append(mustReadFile(t, "example/mpeg2.mp3"), id3v1...)
+
+	for _, tc := range []struct {
+		name      string
+		buf       []byte
+		estimated bool
+	}{
+		{name: "no VBR header", buf: plain, estimated: true},
+		{name: "Xing header", buf: mpeg2WithXing(t)},
+		{name: "LAME tag", buf: mpeg2WithLAME(t, 576, 1104)},
+	} {
+		got, err := mp3.ProbeDuration(bytes.NewReader(tc.buf), int64(len(tc.buf)))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		d, err := mp3.NewDecoder(bytes.NewReader(tc.buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.SampleRate != 22050 || got.Channels != 1 || got.Estimated != tc.estimated {
+			t.Errorf("%s: got: %+v", tc.name, got)
+		}
+		want := d.Duration()
+		if tc.estimated {
+			// The estimation is within a frame for this CBR stream.
+			const frame = 576 * time.Second / 22050
+			if diff := got.Duration - want; diff < -frame || diff > frame {
+				t.Errorf("%s: Duration: got: %v, want: %v", tc.name, got.Duration, want)
+			}
+			continue
+		}
+		if got.Duration != want {
+			t.Errorf("%s: Duration: got: %v, want: %v", tc.name, got.Duration, want)
+		}
+	}
+}
+
+func TestProbeDurationNoFrames(t *testing.T) {
+	buf := bytes.Repeat([]byte("not an MP3 file"), 100)
+	if _, err := mp3.ProbeDuration(bytes.NewReader(buf), int64(len(buf))); !errors.Is(err, mp3.ErrNoValidFrames) {
+		t.Errorf("ProbeDuration: got: %v, want: %v", err, mp3.ErrNoValidFrames)
+	}
+	// The reserved version, Layer III, 128 kbps
+	buf = make([]byte, 417*4)
+	for i := 0; i < 4; i++ {
+		binary.BigEndian.PutUint32(buf[417*i:], 0xffeb9000)
+	}
+	if _, err := mp3.ProbeDuration(bytes.NewReader(buf), int64(len(buf))); !errors.Is(err, mp3.ErrUnsupportedVersion) {
+		t.Errorf("ProbeDuration: got: %v, want: %v", err, mp3.ErrUnsupportedVersion)
+	}
+}
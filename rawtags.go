@@ -0,0 +1,91 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+// TagFormat represents the format of a tag embedded in the stream.
+type TagFormat int
+
+const (
+	// TagFormatID3v2 is an ID3v2 tag.
+	TagFormatID3v2 TagFormat = iota
+
+	// TagFormatID3v1 is a 128-byte ID3v1 or ID3v1.1 tag.
+	TagFormatID3v1
+
+	// TagFormatAPE is an APEv1 or APEv2 tag.
+	TagFormatAPE
+
+	// TagFormatLyrics3 is a Lyrics3 v2.00 tag.
+	TagFormatLyrics3
+)
+
+// String returns the name of the tag format.
+func (f TagFormat) String() string {
+	switch f {
+	case TagFormatID3v2:
+		return "ID3v2"
+	case TagFormatID3v1:
+		return "ID3v1"
+	case TagFormatAPE:
+		return "APE"
+	case TagFormatLyrics3:
+		return "Lyrics3"
+	}
+	return "unknown"
+}
+
+// A RawTag is an undecoded tag in the source.
+type RawTag struct {
+	// Format is the format of the tag.
+	Format TagFormat
+
+	// Range is the byte range of the tag in the source, including the tag's header and footer.
+	Range ByteRange
+
+	// Data is the bytes of the tag in Range.
+	// Data is nil when the tag is too large to be kept in memory (more than 16 MiB).
+	Data []byte
+}
+
+// RawTags returns the tags in the source in the order of their offsets, e.g. for a custom tag parser or for a tool
+// stripping the tags.
+//
+// The tags at the end of the stream are found when the length of the stream is scanned, i.e. when the source is
+// io.Seeker. Otherwise, they are available after the stream is read to the end.
+// Tags in the middle of the stream are not included. Only the first 1024 tags at the beginning of the stream are
+// included.
+//
+// The returned Data must not be modified.
+func (d *Decoder) RawTags() []RawTag {
+	var tags []RawTag
+	for _, b := range d.source.tags {
+		tags = append(tags, b.rawTag())
+	}
+	for _, b := range d.trailingTags() {
+		tags = append(tags, b.rawTag())
+	}
+	return tags
+}
+
+func (b *tagBlock) rawTag() RawTag {
+	return RawTag{
+		Format: b.format,
+		Range: ByteRange{
+			Offset: b.offset,
+			Length: b.size,
+		},
+		Data: b.data,
+	}
+}
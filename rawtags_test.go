@@ -0,0 +1,57 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestRawTags(t *testing.T) {
+	tag1 := append(id3v2Header(20), make([]byte, 20)...)
+	tag2 := append(id3v2Header(30), make([]byte, 30)...)
+	v1 := make([]byte, 128)
+	copy(v1, "TAG")
+
+	var src []byte
+	src = append(src, tag1...)
+	src = append(src, tag2...)
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+	src = append(src, v1...)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []mp3.RawTag{
+		{Format: mp3.TagFormatID3v2, Range: mp3.ByteRange{Offset: 0, Length: int64(len(tag1))}, Data: tag1},
+		{Format: mp3.TagFormatID3v2, Range: mp3.ByteRange{Offset: int64(len(tag1)), Length: int64(len(tag2))}, Data: tag2},
+		{Format: mp3.TagFormatID3v1, Range: mp3.ByteRange{Offset: int64(len(src) - len(v1)), Length: int64(len(v1))}, Data: v1},
+	}
+	got := d.RawTags()
+	if len(got) != len(want) {
+		t.Fatalf("got: %d tags, want: %d tags", len(got), len(want))
+	}
+	for i := range got {
+		if got[i].Format != want[i].Format || got[i].Range != want[i].Range || !bytes.Equal(got[i].Data, want[i].Data) {
+			t.Errorf("RawTags()[%d]: got: %v %v, want: %v %v", i, got[i].Format, got[i].Range, want[i].Format, want[i].Range)
+		}
+		if r := got[i].Range; !bytes.Equal(src[r.Offset:r.Offset+r.Length], got[i].Data) {
+			t.Errorf("RawTags()[%d]: Data doesn't match the source in Range", i)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"io"
+)
+
+// writeToBufferSize is the size of the buffer WriteTo decodes frames into.
+const writeToBufferSize = 8 * maxBytesPerFrame
+
+// remainingLength returns the number of the bytes from the current position to the end of the stream when the scan
+// has established the length, or -1.
+func (d *Decoder) remainingLength() int64 {
+	if !d.scanFinished() || d.length == invalidLength {
+		return invalidLength
+	}
+	n := d.Length() - d.outputPosition(d.pos)
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// DecodeAll decodes the rest of the stream from the current position and returns the PCM, like ioutil.ReadAll(d)
+// does.
+//
+// When the scan has established Length, the PCM is decoded into a buffer of the exact size allocated at once
+// instead of a growing buffer, which halves the peak memory for a large stream.
+func (d *Decoder) DecodeAll() ([]byte, error) {
+	size := d.remainingLength()
+	if size < 0 || int64(int(size)) != size {
+		size = 512
+	}
+	out := make([]byte, 0, size)
+	for {
+		if len(out) == cap(out) {
+			// Check the end of the stream without growing the buffer, which is usual with the exact size.
+			var probe [1]byte
+			n, err := d.read(probe[:])
+			out = append(out, probe[:n]...)
+			if err == io.EOF {
+				return out, nil
+			}
+			if err != nil {
+				return out, err
+			}
+			continue
+		}
+		n, err := d.read(out[len(out):cap(out)])
+		out = out[:len(out)+n]
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+	}
+}
+
+// WriteTo writes the rest of the stream from the current position to w until the end of the stream or an error.
+// WriteTo implements io.WriterTo, so io.Copy uses WriteTo.
+//
+// The frames are decoded into a buffer of a few frames, and the whole stream is not buffered. When w has a Grow
+// method like *bytes.Buffer and the scan has established Length, w is grown to the rest of the stream at once.
+func (d *Decoder) WriteTo(w io.Writer) (int64, error) {
+	if g, ok := w.(interface{ Grow(n int) }); ok {
+		if n := d.remainingLength(); n > 0 && int64(int(n)) == n {
+			g.Grow(int(n))
+		}
+	}
+	buf := make([]byte, writeToBufferSize)
+	var written int64
+	for {
+		n, err := d.read(buf)
+		if n > 0 {
+			m, werr := w.Write(buf[:n])
+			written += int64(m)
+			if werr != nil {
+				return written, werr
+			}
+			if m < n {
+				return written, io.ErrShortWrite
+			}
+		}
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+}
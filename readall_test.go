@@ -0,0 +1,82 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestDecodeAll(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// DecodeAll starts at the current position.
+	const offset = 1000
+	if _, err := io.ReadFull(d, make([]byte, offset)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.DecodeAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want[offset:]) {
+		t.Errorf("DecodeAll doesn't match ioutil.ReadAll")
+	}
+	// The buffer is allocated with the exact size when the length is known.
+	if cap(got) != len(got) {
+		t.Errorf("cap: got: %d, want: %d", cap(got), len(got))
+	}
+
+	// The buffer grows when the length is unknown.
+	d, err = mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = d.DecodeAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("DecodeAll with an unknown length doesn't match ioutil.ReadAll")
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(want)) || !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("io.Copy: got: %d bytes, want: %d bytes", n, len(want))
+	}
+	// The buffer is grown to the length at once. The capacity might be rounded up by the allocator.
+	if max := len(want) + len(want)/16; buf.Cap() > max {
+		t.Errorf("cap: got: %d, want: <= %d", buf.Cap(), max)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3/internal/ape"
+	"github.com/hajimehoshi/go-mp3/internal/id3"
+)
+
+// ReplayGain represents the ReplayGain values of a stream.
+type ReplayGain struct {
+	// TrackGain is the track gain adjustment in dB. TrackGain is valid only when HasTrackGain is true.
+	TrackGain    float64
+	HasTrackGain bool
+
+	// TrackPeak is the peak signal amplitude of the track where 1 is the full scale.
+	// TrackPeak is valid only when HasTrackPeak is true.
+	TrackPeak    float64
+	HasTrackPeak bool
+
+	// AlbumGain is the album gain adjustment in dB. AlbumGain is valid only when HasAlbumGain is true.
+	AlbumGain    float64
+	HasAlbumGain bool
+
+	// AlbumPeak is the peak signal amplitude of the album where 1 is the full scale.
+	// AlbumPeak is valid only when HasAlbumPeak is true.
+	AlbumPeak    float64
+	HasAlbumPeak bool
+}
+
+// ReplayGain returns the ReplayGain values of the stream.
+//
+// The values are read from the TXXX frames like REPLAYGAIN_TRACK_GAIN in the ID3v2 tag, from the items of the same
+// names in the APE tag, and from the LAME tag in this order of precedence. The values in the tags take precedence
+// over the LAME tag as taggers usually update them after encoding. See Tags for when the APE tag is available.
+func (d *Decoder) ReplayGain() ReplayGain {
+	var r ReplayGain
+	if d.source.id3v2 != nil {
+		if tag, err := id3.Parse(d.source.id3v2); err == nil {
+			for _, f := range tag.Frames {
+				if id3.CanonicalID(f.ID, tag.MajorVersion) != "TXXX" {
+					continue
+				}
+				t, err := id3.ParseUserText(f.Data)
+				if err != nil {
+					continue
+				}
+				r.set(t.Description, t.Value)
+			}
+		}
+	}
+
+	if b := d.trailingTag(TagFormatAPE); b != nil && b.data != nil {
+		if items, err := ape.Parse(b.data); err == nil {
+			for _, item := range items {
+				if item.Type == ape.ItemTypeText {
+					r.set(item.Key, item.Text())
+				}
+			}
+		}
+	}
+
+	if l := d.info.LAME; l != nil {
+		if !r.HasTrackGain && l.HasTrackGain {
+			r.TrackGain, r.HasTrackGain = l.TrackGain, true
+		}
+		if !r.HasAlbumGain && l.HasAlbumGain {
+			r.AlbumGain, r.HasAlbumGain = l.AlbumGain, true
+		}
+		// The LAME tag has only the track peak.
+		if !r.HasTrackPeak && l.Peak > 0 {
+			r.TrackPeak, r.HasTrackPeak = l.Peak, true
+		}
+	}
+	return r
+}
+
+// set sets the value of a ReplayGain item like REPLAYGAIN_TRACK_GAIN unless the value is already set.
+// set ignores unknown items and invalid values.
+func (r *ReplayGain) set(name, value string) {
+	var v float64
+	var ok bool
+	switch strings.ToUpper(name) {
+	case "REPLAYGAIN_TRACK_GAIN":
+		v, ok = parseReplayGainValue(value)
+		if ok && !r.HasTrackGain {
+			r.TrackGain, r.HasTrackGain = v, true
+		}
+	case "REPLAYGAIN_TRACK_PEAK":
+		v, ok = parseReplayGainValue(value)
+		if ok && !r.HasTrackPeak {
+			r.TrackPeak, r.HasTrackPeak = v, true
+		}
+	case "REPLAYGAIN_ALBUM_GAIN":
+		v, ok = parseReplayGainValue(value)
+		if ok && !r.HasAlbumGain {
+			r.AlbumGain, r.HasAlbumGain = v, true
+		}
+	case "REPLAYGAIN_ALBUM_PEAK":
+		v, ok = parseReplayGainValue(value)
+		if ok && !r.HasAlbumPeak {
+			r.AlbumPeak, r.HasAlbumPeak = v, true
+		}
+	}
+}
+
+// parseReplayGainValue parses a value like "-6.20 dB" or "0.988235".
+func parseReplayGainValue(s string) (float64, bool) {
+	fs := strings.Fields(s)
+	if len(fs) == 0 {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(fs[0], 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
+
+// setUpReplayGain sets up the constant gain of the ReplayGain mode.
+//
+// setUpReplayGain can be called again after the scan finds the tags at the end of the stream. If the gain changes after
+// frames are decoded, the stream is rewound so that the new gain applies to all the frames.
+func (d *Decoder) setUpReplayGain(mode ReplayGainMode, preamp float64) error {
+	r := d.ReplayGain()
+	var db, peak float64
+	var hasPeak bool
+	switch {
+	case mode == ReplayGainAlbum && r.HasAlbumGain:
+		db = r.AlbumGain
+		peak, hasPeak = r.AlbumPeak, r.HasAlbumPeak
+	case r.HasTrackGain:
+		db = r.TrackGain
+		peak, hasPeak = r.TrackPeak, r.HasTrackPeak
+	default:
+		return nil
+	}
+
+	gain := math.Pow(10, (db+preamp)/20)
+	// Prevent clipping.
+	if hasPeak && peak > 0 && peak*gain > 1 {
+		gain = 1 / peak
+	}
+	if d.normalizer != nil && d.normalizer.gain == gain {
+		return nil
+	}
+	d.normalizer = &loudnessNormalizer{
+		gain: gain,
+	}
+	if d.frame == nil {
+		return nil
+	}
+	if _, err := d.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func txxxFrame(desc, value string) []byte {
+	return id3v24Frame("TXXX", textFrame(desc+"\x00"+value))
+}
+
+func TestReplayGain(t *testing.T) {
+	tag := id3v24Tag(
+		txxxFrame("REPLAYGAIN_TRACK_GAIN", "-6.02 dB"),
+		txxxFrame("REPLAYGAIN_TRACK_PEAK", "0.900000"),
+		txxxFrame("replaygain_album_gain", "+20.00 dB"),
+		txxxFrame("REPLAYGAIN_ALBUM_PEAK", "0.25"),
+	)
+	src := withAudio(t, tag)
+
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := mp3.ReplayGain{
+		TrackGain:    -6.02,
+		HasTrackGain: true,
+		TrackPeak:    0.9,
+		HasTrackPeak: true,
+		AlbumGain:    20,
+		HasAlbumGain: true,
+		AlbumPeak:    0.25,
+		HasAlbumPeak: true,
+	}
+	if got := d.ReplayGain(); got != want {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+
+	orig, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		mode mp3.ReplayGainMode
+		gain float64
+	}{
+		// -6.02 dB is about a half.
+		{name: "track", mode: mp3.ReplayGainTrack, gain: math.Pow(10, -6.02/20)},
+		// +20 dB is limited by the peak.
+		{name: "album", mode: mp3.ReplayGainAlbum, gain: 4},
+	} {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			ReplayGain: tc.mode,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(orig) {
+			t.Fatalf("%s: length: got: %d, want: %d", tc.name, len(got), len(orig))
+		}
+		for i := 0; i < len(got); i += 2 {
+			o := float64(int16(uint16(orig[i]) | uint16(orig[i+1])<<8))
+			v := float64(int16(uint16(got[i]) | uint16(got[i+1])<<8))
+			w := math.Max(-32767, math.Min(32767, o*tc.gain))
+			if math.Abs(v-w) > 1 {
+				t.Fatalf("%s: sample %d: got: %v, want: %v", tc.name, i/2, v, w)
+			}
+		}
+	}
+
+	if _, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		ReplayGain:        mp3.ReplayGainTrack,
+		NormalizeLoudness: true,
+	}); err == nil {
+		t.Errorf("ReplayGain with NormalizeLoudness must fail")
+	}
+}
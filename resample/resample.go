@@ -0,0 +1,275 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resample converts the sample rate of 16-bit little-endian 2-channel PCM, which is the format of the
+// decoded stream of mp3.Decoder.
+//
+// A Reader wraps a reader of PCM, so a decoder can be chained to a sink at another sample rate like this:
+//
+//	d, err := mp3.NewDecoder(f)
+//	...
+//	r, err := resample.NewReader(d, d.SampleRate(), 48000)
+//	...
+//	io.Copy(sink, r)
+package resample
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	// zeroCrossings is the number of the zero crossings of the sinc function on each side of the filter.
+	zeroCrossings = 16
+
+	// maxTaps is the maximum number of the input samples an output sample is interpolated from.
+	maxTaps = 1024
+
+	// maxPhases is the maximum number of the phases of the filter table. When the ratio of the sample rates needs
+	// more phases, the phase of an output sample is rounded to the nearest one in the table.
+	maxPhases = 1024
+
+	// kaiserBeta is the parameter of the Kaiser window, which attenuates the stopband by about 70 dB.
+	kaiserBeta = 7
+
+	// bandwidth is the passband relative to the lower Nyquist frequency of the two sample rates.
+	bandwidth = 0.95
+
+	// inputBufferSize is the size of the buffer to read the source PCM in bytes.
+	inputBufferSize = 4096
+)
+
+// A Reader reads the PCM of a source at another sample rate.
+type Reader struct {
+	src io.Reader
+
+	// The output sample rate is up/down of the input sample rate.
+	up   int64
+	down int64
+
+	// filter[p] is the coefficients for an output sample at the phase p/len(filter) between two input samples.
+	filter [][]float32
+	taps   int
+
+	// in is the input samples of each channel from the input position base. The samples before the start are zero.
+	in   [2][]float32
+	base int64
+
+	// pos and phase are the position of the next output sample in the input: pos + phase/up.
+	pos   int64
+	phase int64
+
+	// inputs is the number of the input samples read so far.
+	inputs int64
+
+	buf  []byte
+	rest int
+	eof  bool
+}
+
+// NewReader returns a Reader that reads the PCM of src at the sample rate from and converts it to the sample rate
+// to.
+//
+// The conversion is by a windowed sinc filter. The output has the same duration as the input: n input samples are
+// converted to ceil(n*to/from) output samples. When from and to are the same, the PCM is read as it is.
+func NewReader(src io.Reader, from, to int) (*Reader, error) {
+	if from <= 0 {
+		return nil, fmt.Errorf("resample: invalid sample rate: %d", from)
+	}
+	if to <= 0 {
+		return nil, fmt.Errorf("resample: invalid sample rate: %d", to)
+	}
+	g := gcd(from, to)
+	r := &Reader{
+		src:  src,
+		up:   int64(to / g),
+		down: int64(from / g),
+		buf:  make([]byte, inputBufferSize),
+	}
+	if r.up == r.down {
+		return r, nil
+	}
+
+	phases := r.up
+	if phases > maxPhases {
+		phases = maxPhases
+	}
+	// For downsampling, the cutoff is lowered, and the filter is widened to keep the zero crossings.
+	cutoff := bandwidth
+	if r.up < r.down {
+		cutoff *= float64(r.up) / float64(r.down)
+	}
+	taps := 2 * int(math.Ceil(zeroCrossings/cutoff))
+	if taps > maxTaps {
+		taps = maxTaps
+	}
+	r.taps = taps
+	r.filter = make([][]float32, phases)
+	coeffs := make([]float64, taps)
+	for p := range r.filter {
+		var sum float64
+		for j := range coeffs {
+			// The distance from the output sample to the input sample j.
+			t := float64(j-taps/2+1) - float64(p)/float64(phases)
+			c := cutoff * sinc(cutoff*t) * kaiser(t/float64(taps/2))
+			coeffs[j] = c
+			sum += c
+		}
+		// Normalize the gain for DC.
+		r.filter[p] = make([]float32, taps)
+		for j, c := range coeffs {
+			r.filter[p][j] = float32(c / sum)
+		}
+	}
+
+	// Pad the samples before the start with zeros.
+	for ch := range r.in {
+		r.in[ch] = make([]float32, taps/2-1, inputBufferSize)
+	}
+	r.base = -int64(taps/2 - 1)
+	return r, nil
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	x *= math.Pi
+	return math.Sin(x) / x
+}
+
+// kaiser returns the Kaiser window at x in [-1, 1].
+func kaiser(x float64) float64 {
+	if x < -1 || x > 1 {
+		return 0
+	}
+	return besselI0(kaiserBeta*math.Sqrt(1-x*x)) / besselI0(kaiserBeta)
+}
+
+// besselI0 returns the modified Bessel function of the first kind of order 0.
+func besselI0(x float64) float64 {
+	sum := 1.0
+	term := 1.0
+	for k := 1; term > sum*1e-12; k++ {
+		term *= (x / 2) * (x / 2) / float64(k*k)
+		sum += term
+	}
+	return sum
+}
+
+// Read reads the converted PCM. The size of buf should be a multiple of 4, which is the size of a sample.
+func (r *Reader) Read(buf []byte) (int, error) {
+	if r.filter == nil {
+		return r.src.Read(buf)
+	}
+
+	n := 0
+	for n+4 <= len(buf) {
+		// The samples from pos-taps/2+1 to pos+taps/2+1 are needed. The last one is for the phase rounded up.
+		half := int64(r.taps / 2)
+		for !r.eof && r.base+int64(len(r.in[0])) <= r.pos+half+1 {
+			if err := r.fill(); err != nil {
+				// The read samples are kept. The next Read reads the source again.
+				if n > 0 {
+					return n, nil
+				}
+				return 0, err
+			}
+		}
+		if r.eof && r.pos >= r.inputs {
+			break
+		}
+
+		p := r.phase
+		if r.up > maxPhases {
+			p = (p*maxPhases + r.up/2) / r.up
+		}
+		coeffs := r.filter[p%int64(len(r.filter))]
+		offset := r.pos - half + 1
+		if p == int64(len(r.filter)) {
+			// The phase is rounded to the next input sample.
+			offset++
+		}
+		for ch := range r.in {
+			in := r.in[ch][offset-r.base:][:len(coeffs)]
+			var v float32
+			for j, c := range coeffs {
+				v += c * in[j]
+			}
+			s := int16(clamp(math.Round(float64(v))))
+			buf[n+2*ch] = byte(s)
+			buf[n+2*ch+1] = byte(s >> 8)
+		}
+		n += 4
+
+		r.phase += r.down
+		r.pos += r.phase / r.up
+		r.phase %= r.up
+	}
+	if n == 0 && len(buf) >= 4 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+func clamp(v float64) float64 {
+	if v > 32767 {
+		return 32767
+	}
+	if v < -32768 {
+		return -32768
+	}
+	return v
+}
+
+// fill reads the source and appends the samples to the input buffer.
+// At the end of the source, the samples after the end are padded with zeros.
+func (r *Reader) fill() error {
+	// Discard the samples no longer needed.
+	if drop := r.pos - int64(r.taps/2) + 1 - r.base; drop > 0 {
+		for ch := range r.in {
+			r.in[ch] = append(r.in[ch][:0], r.in[ch][drop:]...)
+		}
+		r.base += drop
+	}
+
+	n, err := r.src.Read(r.buf[r.rest:])
+	n += r.rest
+	aligned := n &^ 3
+	for i := 0; i < aligned; i += 4 {
+		for ch := range r.in {
+			s := int16(uint16(r.buf[i+2*ch]) | uint16(r.buf[i+2*ch+1])<<8)
+			r.in[ch] = append(r.in[ch], float32(s))
+		}
+	}
+	r.inputs += int64(aligned / 4)
+	r.rest = copy(r.buf, r.buf[aligned:n])
+
+	if err == io.EOF {
+		r.eof = true
+		for ch := range r.in {
+			r.in[ch] = append(r.in[ch], make([]float32, r.taps+1)...)
+		}
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resample_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+	. "github.com/hajimehoshi/go-mp3/resample"
+)
+
+// sine returns n samples of a sine wave of the frequency at the sample rate.
+func sine(freq float64, sampleRate, n int) []byte {
+	pcm := make([]byte, n*4)
+	for i := 0; i < n; i++ {
+		v := int16(math.Round(10000 * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate))))
+		binary.LittleEndian.PutUint16(pcm[4*i:], uint16(v))
+		binary.LittleEndian.PutUint16(pcm[4*i+2:], uint16(-v))
+	}
+	return pcm
+}
+
+func TestReader(t *testing.T) {
+	for _, tc := range []struct {
+		from, to int
+	}{
+		{from: 44100, to: 48000},
+		{from: 48000, to: 44100},
+		{from: 22050, to: 48000},
+		{from: 48000, to: 8000},
+		// The ratio needs more phases than the filter table has.
+		{from: 44100, to: 48001},
+	} {
+		const freq = 1000
+		const n = 10000
+		r, err := NewReader(bytes.NewReader(sine(freq, tc.from, n)), tc.from, tc.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(got), (n*tc.to+tc.from-1)/tc.from*4; got != want {
+			t.Errorf("%d Hz -> %d Hz: length: got: %d, want: %d", tc.from, tc.to, got, want)
+		}
+		want := sine(freq, tc.to, len(got)/4)
+		// Skip the edges, where the filter sees the zeros outside the input.
+		var maxDiff int
+		for i := 100; i < len(got)/4-100; i++ {
+			for ch := 0; ch < 2; ch++ {
+				g := int(int16(binary.LittleEndian.Uint16(got[4*i+2*ch:])))
+				w := int(int16(binary.LittleEndian.Uint16(want[4*i+2*ch:])))
+				if d := g - w; d > maxDiff {
+					maxDiff = d
+				} else if -d > maxDiff {
+					maxDiff = -d
+				}
+			}
+		}
+		// The error is less than 0.1% of the amplitude.
+		if maxDiff > 10 {
+			t.Errorf("%d Hz -> %d Hz: the maximum difference: %d", tc.from, tc.to, maxDiff)
+		}
+	}
+}
+
+func TestReaderSameRate(t *testing.T) {
+	pcm := sine(1000, 44100, 1000)
+	r, err := NewReader(bytes.NewReader(pcm), 44100, 44100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pcm) {
+		t.Errorf("the PCM must not be changed")
+	}
+}
+
+func TestReaderDecoder(t *testing.T) {
+	f, err := ioutil.ReadFile("../example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoder(bytes.NewReader(f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(d, d.SampleRate(), 48000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Read in small pieces to check the state across the calls.
+	var n int64
+	buf := make([]byte, 1000)
+	for {
+		m, err := r.Read(buf)
+		n += int64(m)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	samples := d.Length() / 4
+	if got, want := n, (samples*48000+int64(d.SampleRate())-1)/int64(d.SampleRate())*4; got != want {
+		t.Errorf("length: got: %d, want: %d", got, want)
+	}
+}
+
+func TestNewReaderInvalidRate(t *testing.T) {
+	if _, err := NewReader(bytes.NewReader(nil), 0, 48000); err == nil {
+		t.Errorf("NewReader with 0 Hz must fail")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WAVE format tags for MPEG audio.
+const (
+	waveFormatMPEG       = 0x0050
+	waveFormatMPEGLayer3 = 0x0055
+)
+
+// skipRIFF skips the header and the chunks before the data chunk of a RIFF/WAVE file, and limits s to the data
+// chunk. The first 3 bytes "RIF" must be already read.
+//
+// If the stream is not a RIFF/WAVE file, skipRIFF unreads the read bytes and does nothing.
+func (s *source) skipRIFF() error {
+	// "F", the size (4 bytes) and "WAVE"
+	header := make([]byte, 9)
+	n, err := s.ReadFull(header)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if n < len(header) || header[0] != 'F' || string(header[5:]) != "WAVE" {
+		s.Unread(append([]byte("RIF"), header[:n]...))
+		return nil
+	}
+
+	chunk := make([]byte, 8)
+	for {
+		if _, err := s.ReadFull(chunk); err != nil {
+			if err == io.EOF {
+				return errors.New("mp3: RIFF/WAVE data chunk is not found")
+			}
+			return err
+		}
+		size := int64(binary.LittleEndian.Uint32(chunk[4:]))
+		switch string(chunk[:4]) {
+		case "fmt ":
+			if size < 2 {
+				return errors.New("mp3: RIFF/WAVE fmt chunk is too short")
+			}
+			// Only the format tag is needed.
+			buf := make([]byte, 2)
+			if _, err := s.ReadFull(buf); err != nil {
+				return err
+			}
+			if f := binary.LittleEndian.Uint16(buf); f != waveFormatMPEG && f != waveFormatMPEGLayer3 {
+				return fmt.Errorf("mp3: RIFF/WAVE doesn't contain MPEG audio (format tag: 0x%04x)", f)
+			}
+			if err := s.discard(size - 2 + size&1); err != nil {
+				return err
+			}
+		case "data":
+			s.end = s.pos + size
+			return nil
+		default:
+			// Chunks are padded to even sizes.
+			if err := s.discard(size + size&1); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"errors"
+	"sync/atomic"
+	"unsafe"
+)
+
+// RingBufferHeaderSize is the size of the header at the beginning of a ring buffer's memory.
+const RingBufferHeaderSize = 16
+
+// A RingBuffer is a single-producer single-consumer ring buffer of PCM bytes over caller-provided memory,
+// typically a shared memory region mapped by mmap and read by another process like an audio server.
+//
+// The memory layout is:
+//
+//	[0:8]   head: the total number of bytes written, updated by the producer
+//	[8:16]  tail: the total number of bytes read, updated by the consumer
+//	[16:]   data
+//
+// head and tail are uint64 values in the native byte order and are accessed atomically.
+// The byte at the total offset n is stored at data[n % len(data)].
+// The data is empty when head == tail, and full when head - tail == len(data).
+type RingBuffer struct {
+	mem  []byte
+	data []byte
+}
+
+// NewRingBuffer returns a RingBuffer over mem.
+//
+// mem must be 8-byte aligned, and the size of the data region after the header must be a positive multiple of 4.
+// NewRingBuffer doesn't initialize head and tail. The creator of the shared memory is responsible for zeroing them.
+func NewRingBuffer(mem []byte) (*RingBuffer, error) {
+	if len(mem) <= RingBufferHeaderSize {
+		return nil, errors.New("mp3: ring buffer memory is too small")
+	}
+	if uintptr(unsafe.Pointer(&mem[0]))%8 != 0 {
+		return nil, errors.New("mp3: ring buffer memory must be 8-byte aligned")
+	}
+	if (len(mem)-RingBufferHeaderSize)%4 != 0 {
+		return nil, errors.New("mp3: ring buffer data size must be a multiple of 4")
+	}
+	return &RingBuffer{
+		mem:  mem,
+		data: mem[RingBufferHeaderSize:],
+	}, nil
+}
+
+func (r *RingBuffer) headPtr() *uint64 {
+	return (*uint64)(unsafe.Pointer(&r.mem[0]))
+}
+
+func (r *RingBuffer) tailPtr() *uint64 {
+	return (*uint64)(unsafe.Pointer(&r.mem[8]))
+}
+
+// writable returns the contiguous free region after head.
+func (r *RingBuffer) writable() []byte {
+	head := atomic.LoadUint64(r.headPtr())
+	tail := atomic.LoadUint64(r.tailPtr())
+	size := uint64(len(r.data))
+	free := size - (head - tail)
+	start := head % size
+	if free > size-start {
+		free = size - start
+	}
+	return r.data[start : start+free]
+}
+
+// readable returns the contiguous filled region after tail.
+func (r *RingBuffer) readable() []byte {
+	head := atomic.LoadUint64(r.headPtr())
+	tail := atomic.LoadUint64(r.tailPtr())
+	size := uint64(len(r.data))
+	used := head - tail
+	start := tail % size
+	if used > size-start {
+		used = size - start
+	}
+	return r.data[start : start+used]
+}
+
+// Read reads PCM bytes from the ring buffer and advances its tail.
+//
+// Read is for a consumer in the same process. Read doesn't block, and returns 0 when the ring buffer is empty.
+func (r *RingBuffer) Read(buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		b := r.readable()
+		if len(b) == 0 {
+			break
+		}
+		m := copy(buf[n:], b)
+		atomic.AddUint64(r.tailPtr(), uint64(m))
+		n += m
+	}
+	return n, nil
+}
+
+// WriteToRingBuffer decodes PCM directly into the free space of r and advances its head.
+//
+// WriteToRingBuffer doesn't block. It fills as much free space as possible and returns the number of written
+// bytes. WriteToRingBuffer returns io.EOF when the stream ends.
+func (d *Decoder) WriteToRingBuffer(r *RingBuffer) (int, error) {
+	n := 0
+	for {
+		b := r.writable()
+		if len(b) == 0 {
+			return n, nil
+		}
+		m, err := d.read(b)
+		atomic.AddUint64(r.headPtr(), uint64(m))
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+}
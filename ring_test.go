@@ -0,0 +1,84 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestRingBuffer(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	for _, c := range []struct {
+		name    string
+		options *mp3.DecoderOptions
+	}{
+		{"default", nil},
+		// WriteToRingBuffer fills the free space regardless of the frame boundaries.
+		{"frames per read", &mp3.DecoderOptions{FramesPerRead: 1}},
+	} {
+		testRingBuffer(t, c.name, c.options, want)
+	}
+}
+
+func testRingBuffer(t *testing.T, name string, options *mp3.DecoderOptions, want []byte) {
+	t.Helper()
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := mp3.NewRingBuffer(make([]byte, mp3.RingBufferHeaderSize+3000*4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []byte
+	tmp := make([]byte, 1234*4)
+	for {
+		_, err := d.WriteToRingBuffer(r)
+		if err != nil && err != io.EOF {
+			t.Fatalf("%s: %v", name, err)
+		}
+		n, _ := r.Read(tmp)
+		got = append(got, tmp[:n]...)
+		if err == io.EOF {
+			for {
+				n, _ := r.Read(tmp)
+				if n == 0 {
+					break
+				}
+				got = append(got, tmp[:n]...)
+			}
+			break
+		}
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s: PCM via the ring buffer doesn't match: got: %d bytes, want: %d bytes", name, len(got), len(want))
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"testing"
+)
+
+// gatedReader is a bytes.Reader whose first ReadAt blocks until gate is closed.
+type gatedReader struct {
+	*bytes.Reader
+	started chan struct{}
+	gate    chan struct{}
+	calls   int32
+}
+
+func (g *gatedReader) ReadAt(buf []byte, offset int64) (int, error) {
+	if atomic.AddInt32(&g.calls, 1) == 1 {
+		close(g.started)
+		<-g.gate
+	}
+	return g.Reader.ReadAt(buf, offset)
+}
+
+func TestCloseStopsBackgroundScan(t *testing.T) {
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &gatedReader{
+		Reader:  bytes.NewReader(buf),
+		started: make(chan struct{}),
+		gate:    make(chan struct{}),
+	}
+	d, err := NewDecoderWithOptions(r, &DecoderOptions{
+		BackgroundScan: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Close the decoder while the scan is blocked in its first ReadAt.
+	<-r.started
+	closed := make(chan error)
+	go func() {
+		closed <- d.Close()
+	}()
+	<-d.scanStop
+	close(r.gate)
+	if err := <-closed; err != nil {
+		t.Fatal(err)
+	}
+
+	// The scan stopped right after the blocked ReadAt.
+	if got := atomic.LoadInt32(&r.calls); got != 1 {
+		t.Errorf("ReadAt calls: got: %d, want: 1", got)
+	}
+	if !errors.Is(d.scanErr, errDecoderClosed) {
+		t.Errorf("scan error: got: %v, want: %v", d.scanErr, errDecoderClosed)
+	}
+	if _, err := d.Read(make([]byte, 4)); err != errDecoderClosed {
+		t.Errorf("Read after Close: got: %v, want: %v", err, errDecoderClosed)
+	}
+	if _, err := d.Seek(0, io.SeekStart); err != errDecoderClosed {
+		t.Errorf("Seek after Close: got: %v, want: %v", err, errDecoderClosed)
+	}
+	if err := d.Close(); err != nil {
+		t.Errorf("second Close: got: %v, want: nil", err)
+	}
+}
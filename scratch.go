@@ -0,0 +1,73 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"github.com/hajimehoshi/go-mp3/internal/frame"
+)
+
+// A Scratch is the scratch memory of a Decoder: the state of the synthesis, the bit reservoir and the PCM buffers,
+// which take about 70 KB.
+//
+// A Decoder allocates its scratch memory while the first frames are decoded. After that, decoding doesn't allocate,
+// including seeking. A Scratch given to NewDecoderWithOptions via DecoderOptions.Scratch is used instead, so that
+// the memory is recycled when many streams are decoded one after another, e.g. on a server.
+//
+// A Scratch must not be used by two Decoders at the same time.
+type Scratch struct {
+	frame *frame.Frame
+	pcm   []byte
+	buf   []byte
+}
+
+// NewScratch returns a new Scratch with all the memory allocated.
+func NewScratch() *Scratch {
+	return &Scratch{
+		frame: frame.New(),
+		pcm:   make([]byte, 0, maxBytesPerFrame),
+		buf:   make([]byte, pcmBufferSize),
+	}
+}
+
+// Size returns the number of the bytes of the memory s holds.
+func (s *Scratch) Size() int {
+	n := cap(s.pcm) + cap(s.buf)
+	if s.frame != nil {
+		n += s.frame.Size()
+	}
+	return n
+}
+
+// Scratch returns the scratch memory of d.
+//
+// The returned Scratch can be given to another Decoder via DecoderOptions.Scratch after d is no longer used.
+func (d *Decoder) Scratch() *Scratch {
+	f := d.frame
+	if f == nil {
+		f = d.spareFrame
+	}
+	return &Scratch{
+		frame: f,
+		pcm:   d.pcm[:0],
+		buf:   d.buf.mem,
+	}
+}
+
+// useScratch makes d use the memory of s.
+func (d *Decoder) useScratch(s *Scratch) {
+	d.spareFrame = s.frame
+	d.pcm = s.pcm[:0]
+	d.buf.mem = s.buf
+}
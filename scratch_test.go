@@ -0,0 +1,112 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestDecodeAllocs(t *testing.T) {
+	buf := mustReadFile(t, "example/classic.mp3")
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 4608 bytes is the size of a frame of classic.mp3.
+	p := make([]byte, 4608)
+	small := make([]byte, 1000)
+	// Warm up.
+	for i := 0; i < 4; i++ {
+		if _, err := d.Read(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, c := range []struct {
+		name string
+		f    func() error
+	}{
+		{"Read", func() error {
+			_, err := d.Read(p)
+			return err
+		}},
+		{"Read with a small buffer", func() error {
+			_, err := d.Read(small)
+			return err
+		}},
+		{"Seek and Read", func() error {
+			if _, err := d.Seek(4608*50+400, io.SeekStart); err != nil {
+				return err
+			}
+			_, err := d.Read(p)
+			return err
+		}},
+	} {
+		var err error
+		n := testing.AllocsPerRun(100, func() {
+			if e := c.f(); e != nil && err == nil {
+				err = e
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n > 0 {
+			t.Errorf("%s: allocations: got: %v, want: 0", c.name, n)
+		}
+	}
+}
+
+func TestScratch(t *testing.T) {
+	buf := mustReadFile(t, "example/classic.mp3")
+	// 4608 bytes is the size of a frame of classic.mp3.
+	const size = 200 * 4608
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadAll(io.LimitReader(d, size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := mp3.NewScratch()
+	scratchSize := s.Size()
+	for i := 0; i < 2; i++ {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+			Scratch: s,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Decode only a part of the stream so that the next decoder starts with the state in the middle.
+		got, err := ioutil.ReadAll(io.LimitReader(d, int64(size/(2-i))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want[:len(got)]) {
+			t.Errorf("decoder %d: decoded PCM doesn't match", i)
+		}
+		s = d.Scratch()
+		if got := s.Size(); got != scratchSize {
+			t.Errorf("decoder %d: Scratch().Size(): got: %d, want: %d", i, got, scratchSize)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+)
+
+// ErrParameterChange is the error when the stream parameters change in the middle of a stream with
+// ParameterChangeError.
+var ErrParameterChange = errors.New("mp3: stream parameters changed")
+
+// sameParameters reports whether the two frame headers have the same stream parameters.
+func sameParameters(a, b frameheader.FrameHeader) bool {
+	return a.ID() == b.ID() && a.SamplingFrequency() == b.SamplingFrequency() &&
+		a.NumberOfChannels() == b.NumberOfChannels()
+}
+
+// checkParameters checks whether the stream parameters of the frame h change from the current segment.
+func (d *Decoder) checkParameters(h frameheader.FrameHeader) error {
+	if d.segment == 0 {
+		d.segment = h
+		return nil
+	}
+	if sameParameters(d.segment, h) {
+		return nil
+	}
+
+	freq, err := h.SamplingFrequencyValue()
+	if err != nil {
+		return err
+	}
+	if d.parameterChange == ParameterChangeError {
+		prev, err := d.segment.SamplingFrequencyValue()
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %d Hz %d ch -> %d Hz %d ch", ErrParameterChange,
+			prev, d.segment.NumberOfChannels(), freq, h.NumberOfChannels())
+	}
+
+	d.segment = h
+	if d.onSegment != nil {
+		d.onSegment(StreamInfo{
+			SampleRate: freq,
+			Channels:   h.NumberOfChannels(),
+			Frames:     -1,
+			Bytes:      -1,
+			Quality:    -1,
+		})
+	}
+	return nil
+}
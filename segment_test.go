@@ -0,0 +1,121 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// concatenated returns example/mpeg2.mp3 (22050 Hz, mono) followed by the head of example/classic.mp3
+// (44100 Hz, stereo).
+func concatenated(t *testing.T) []byte {
+	t.Helper()
+	buf := mustReadFile(t, "example/mpeg2.mp3")
+	return append(buf, mustReadFile(t, "example/classic.mp3")[:100000]...)
+}
+
+func TestParameterChangeContinue(t *testing.T) {
+	var segments []mp3.StreamInfo
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(concatenated(t)), &mp3.DecoderOptions{
+		OnSegment: func(info mp3.StreamInfo) {
+			segments = append(segments, info)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcm, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	if len(pcm) <= len(want) || !bytes.Equal(pcm[:len(want)], want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+	if len(segments) != 1 || segments[0].SampleRate != 44100 || segments[0].Channels != 2 {
+		t.Errorf("segments: got: %+v", segments)
+	}
+}
+
+func TestParameterChangeError(t *testing.T) {
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(concatenated(t)), &mp3.DecoderOptions{
+		ParameterChange: mp3.ParameterChangeError,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcm, err := ioutil.ReadAll(d)
+	if !errors.Is(err, mp3.ErrParameterChange) {
+		t.Errorf("err: got: %v, want: %v", err, mp3.ErrParameterChange)
+	}
+	if want := decodeAll(t, "example/mpeg2.mp3", nil); !bytes.Equal(pcm, want) {
+		t.Errorf("decoded PCM doesn't match")
+	}
+}
+
+func TestSeekOverParameterChange(t *testing.T) {
+	src := concatenated(t)
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The MPEG-2 frames are decoded to 2304 bytes and the MPEG-1 frames are decoded to 4608 bytes.
+	first := int64(len(decodeAll(t, "example/mpeg2.mp3", nil)))
+	for _, offset := range []int64{1000 * 4, first - 100*4, first, first + 12345*4} {
+		if _, err := d.Seek(offset, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, all[offset:]) {
+			t.Errorf("offset %d: decoded PCM after Seek doesn't match", offset)
+		}
+	}
+
+	d, err = mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		SeekMode: mp3.SeekModeFast,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := first + 12345*4
+	pos, err := d.Seek(offset, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The position is rounded down to the start of an MPEG-1 frame.
+	if pos > offset || offset-pos >= 4608 || (pos-first)%4608 != 0 {
+		t.Errorf("Seek with SeekModeFast: got: %d, want: the start of the MPEG-1 frame at %d", pos, offset)
+	}
+	rest, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := int64(len(rest)), int64(len(all))-pos; got != want {
+		t.Errorf("the length after Seek with SeekModeFast: got: %d, want: %d", got, want)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+	"math"
+	"time"
+)
+
+// Default values of SilenceOptions.
+const (
+	DefaultSilenceThreshold   = -60
+	DefaultSilenceMinDuration = 2 * time.Second
+)
+
+// SilenceOptions represents options for silence detection.
+type SilenceOptions struct {
+	// Threshold is the level in dBFS at or below which a sample is silent, like -50.
+	// A sample is silent when the absolute values of both the channels are at or below the level.
+	// If Threshold is 0, DefaultSilenceThreshold is used.
+	Threshold float64
+
+	// MinDuration is the minimum duration of a silent region. Shorter silent regions are not reported.
+	// If MinDuration is 0, DefaultSilenceMinDuration is used.
+	MinDuration time.Duration
+}
+
+// A Silence is a silent region.
+type Silence struct {
+	// Start and End are the positions of the first silent sample and the sample just after the region, in samples
+	// per channel. Multiply them by 4 for the positions in bytes of the decoded stream.
+	Start int64
+	End   int64
+}
+
+// A SilenceDetector detects silent regions in PCM written to it.
+//
+// The PCM is formatted in the same way as Decoder: 16bit (little endian) 2 channels.
+type SilenceDetector struct {
+	threshold  int
+	minSamples int64
+
+	// pos is the position of the next sample.
+	pos int64
+
+	// start is the start of the current silent run, or -1 if the last sample is not silent.
+	start int64
+
+	// partial is the bytes of an incomplete sample written last.
+	partial  [4]byte
+	npartial int
+
+	silences []Silence
+}
+
+// NewSilenceDetector returns a new SilenceDetector for PCM of the sample rate.
+//
+// options can be nil. A nil options is the same as the default options.
+func NewSilenceDetector(sampleRate int, options *SilenceOptions) *SilenceDetector {
+	if options == nil {
+		options = &SilenceOptions{}
+	}
+	threshold := options.Threshold
+	if threshold == 0 {
+		threshold = DefaultSilenceThreshold
+	}
+	minDuration := options.MinDuration
+	if minDuration == 0 {
+		minDuration = DefaultSilenceMinDuration
+	}
+	return &SilenceDetector{
+		threshold:  int(math.Floor(32768 * math.Pow(10, threshold/20))),
+		minSamples: int64(minDuration) * int64(sampleRate) / int64(time.Second),
+		start:      -1,
+	}
+}
+
+// Write analyzes the PCM. Write always succeeds.
+//
+// The PCM doesn't have to be aligned to samples. An incomplete sample is analyzed with the next Write.
+func (s *SilenceDetector) Write(pcm []byte) (int, error) {
+	n := len(pcm)
+	if s.npartial > 0 {
+		c := copy(s.partial[s.npartial:], pcm)
+		s.npartial += c
+		pcm = pcm[c:]
+		if s.npartial < len(s.partial) {
+			return n, nil
+		}
+		s.add(s.partial[:])
+		s.npartial = 0
+	}
+	for len(pcm) >= 4 {
+		s.add(pcm[:4])
+		pcm = pcm[4:]
+	}
+	s.npartial = copy(s.partial[:], pcm)
+	return n, nil
+}
+
+// add analyzes one sample.
+func (s *SilenceDetector) add(sample []byte) {
+	l := int(int16(uint16(sample[0]) | uint16(sample[1])<<8))
+	r := int(int16(uint16(sample[2]) | uint16(sample[3])<<8))
+	if abs(l) <= s.threshold && abs(r) <= s.threshold {
+		if s.start < 0 {
+			s.start = s.pos
+		}
+	} else if s.start >= 0 {
+		s.appendRun(s.pos)
+		s.start = -1
+	}
+	s.pos++
+}
+
+// appendRun appends the current silent run ending at end if the run is long enough.
+func (s *SilenceDetector) appendRun(end int64) {
+	if end-s.start >= s.minSamples {
+		s.silences = append(s.silences, Silence{Start: s.start, End: end})
+	}
+}
+
+// Silences returns the silent regions detected so far.
+//
+// The silent region continuing at the end of the PCM written so far is included if it is long enough, so
+// Silences after writing the whole PCM returns the silent regions including the one at the end.
+func (s *SilenceDetector) Silences() []Silence {
+	silences := append([]Silence(nil), s.silences...)
+	if s.start >= 0 && s.pos-s.start >= s.minSamples {
+		silences = append(silences, Silence{Start: s.start, End: s.pos})
+	}
+	return silences
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// DetectSilence decodes the rest of the stream from the current position and returns the silent regions.
+//
+// The positions of the regions are in the decoded stream, which starts at the beginning of the stream regardless of
+// the current position. options can be nil. A nil options is the same as the default options.
+func (d *Decoder) DetectSilence(options *SilenceOptions) ([]Silence, error) {
+	pos, err := d.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	s := NewSilenceDetector(d.SampleRate(), options)
+	s.pos = pos / 4
+	if _, err := d.WriteTo(s); err != nil {
+		return nil, err
+	}
+	return s.Silences(), nil
+}
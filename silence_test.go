@@ -0,0 +1,121 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestSilenceDetector(t *testing.T) {
+	const rate = 1000
+
+	// 0-100: loud, 100-400: silent, 400-450: loud, 450-500: silent, 500-520: loud,
+	// 520-1000: quiet below -40 dBFS (327), which is silent with the threshold.
+	pcm := make([]byte, 1000*4)
+	for i := 0; i < 1000; i++ {
+		var v int16
+		switch {
+		case i < 100, 400 <= i && i < 450, 500 <= i && i < 520:
+			v = 10000
+		case i >= 520:
+			v = -300
+		}
+		// Only the right channel is loud, which must be not silent.
+		binary.LittleEndian.PutUint16(pcm[4*i+2:], uint16(v))
+	}
+
+	d := mp3.NewSilenceDetector(rate, &mp3.SilenceOptions{
+		Threshold:   -40,
+		MinDuration: 100 * time.Millisecond,
+	})
+	// Write in odd sizes so that samples are split across the writes.
+	for buf := pcm; len(buf) > 0; {
+		n := 7
+		if n > len(buf) {
+			n = len(buf)
+		}
+		d.Write(buf[:n])
+		buf = buf[n:]
+	}
+	want := []mp3.Silence{
+		{Start: 100, End: 400},
+		{Start: 520, End: 1000},
+	}
+	if got := d.Silences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Silences: got: %v, want: %v", got, want)
+	}
+
+	// With the default threshold of -60 dBFS, the quiet part is not silent.
+	d = mp3.NewSilenceDetector(rate, &mp3.SilenceOptions{
+		MinDuration: 40 * time.Millisecond,
+	})
+	d.Write(pcm)
+	want = []mp3.Silence{
+		{Start: 100, End: 400},
+		{Start: 450, End: 500},
+	}
+	if got := d.Silences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Silences: got: %v, want: %v", got, want)
+	}
+}
+
+func TestDetectSilence(t *testing.T) {
+	pcm := decodeAll(t, "example/mpeg2.mp3", nil)
+	options := &mp3.SilenceOptions{
+		Threshold:   -30,
+		MinDuration: 100 * time.Millisecond,
+	}
+	sd := mp3.NewSilenceDetector(22050, options)
+	sd.Write(pcm)
+	want := sd.Silences()
+	if len(want) == 0 {
+		t.Fatal("no silence is found")
+	}
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.DetectSilence(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectSilence: got: %v, want: %v", got, want)
+	}
+
+	// The positions are absolute after seeking.
+	last := want[len(want)-1]
+	if _, err := d.Seek(last.Start*4, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	got, err = d.DetectSilence(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want[len(want)-1:]) {
+		t.Errorf("DetectSilence after Seek: got: %v, want: %v", got, want[len(want)-1:])
+	}
+}
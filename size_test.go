@@ -0,0 +1,63 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// maxDecoderBinarySize is the maximum size the decoder adds to a program with the mp3minimal build tag.
+// The size is measured against a program doing the same I/O without the decoder, built by the same toolchain.
+// The size was 856,413 bytes (about 836 KiB) with Go 1.27 on linux/amd64.
+const maxDecoderBinarySize = 900 << 10
+
+// TestMinimalBinarySize runs only when the environment variable GO_MP3_SIZE_TEST is set, as it builds programs.
+func TestMinimalBinarySize(t *testing.T) {
+	if os.Getenv("GO_MP3_SIZE_TEST") == "" {
+		t.Skip("GO_MP3_SIZE_TEST is not set")
+	}
+	gocmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not found")
+	}
+
+	dir, err := ioutil.TempDir("", "go-mp3-size")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	build := func(pkg string) int64 {
+		bin := filepath.Join(dir, filepath.Base(pkg))
+		cmd := exec.Command(gocmd, "build", "-tags", "mp3minimal", "-o", bin, pkg)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go build %s failed: %v\n%s", pkg, err, out)
+		}
+		fi, err := os.Stat(bin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fi.Size()
+	}
+	size := build("./internal/sizecheck")
+	baseline := build("./internal/sizecheck/baseline")
+	if got := size - baseline; got > maxDecoderBinarySize {
+		t.Errorf("binary size added by the decoder: got: %d (%d - %d), want: <= %d", got, size, baseline, maxDecoderBinarySize)
+	}
+}
@@ -15,14 +15,47 @@
 package mp3
 
 import (
+	"encoding/binary"
 	"errors"
 	"io"
+
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
 )
 
+// tagBlock is a tag in the source.
+type tagBlock struct {
+	format TagFormat
+	offset int64
+	size   int64
+
+	// data is the tag including its header. data is nil if the tag is too large to be kept.
+	data []byte
+}
+
 type source struct {
 	reader io.Reader
-	buf    []byte
 	pos    int64
+
+	// buf[r:w] is the bytes read ahead from reader or given back by Unread, which precede the rest of reader.
+	buf []byte
+	r   int
+	w   int
+
+	// id3v2 is the ID3v2 tag at the beginning of the stream including its header.
+	id3v2 []byte
+
+	// tags is the tags skipped at the beginning of the stream.
+	tags []tagBlock
+
+	// trailing is the tags found at the end of the stream. trailing is empty until the end is reached.
+	trailing []tagBlock
+
+	// end is the end position of the MPEG audio data in a container like RIFF/WAVE.
+	// end is 0 when the MPEG audio data continues to the end of the stream.
+	end int64
+
+	// headerOptions is the options to validate frame headers.
+	headerOptions frameheader.Options
 }
 
 func (s *source) Seek(position int64, whence int) (int64, error) {
@@ -30,82 +63,365 @@ func (s *source) Seek(position int64, whence int) (int64, error) {
 	if !ok {
 		return 0, errors.New("mp3: source must be io.Seeker")
 	}
-	s.buf = nil
+	if whence == io.SeekCurrent {
+		// reader is ahead of pos by the buffered bytes.
+		position += s.pos
+		whence = io.SeekStart
+	}
+	s.r, s.w = 0, 0
 	n, err := seeker.Seek(position, whence)
 	if err != nil {
-		return 0, err
+		return 0, &SourceError{Op: "seek", Err: err}
 	}
 	s.pos = n
 	return n, nil
 }
 
-func (s *source) skipTags() error {
-	buf := make([]byte, 3)
-	if _, err := s.ReadFull(buf); err != nil {
-		return err
-	}
-	switch string(buf) {
-	case "TAG":
-		buf := make([]byte, 125)
-		if _, err := s.ReadFull(buf); err != nil {
-			return err
-		}
+const (
+	// id3v2HeaderSize is the size of an ID3v2 header and of an ID3v2.4 footer.
+	id3v2HeaderSize = 10
 
-	case "ID3":
-		// Skip version (2 bytes) and flag (1 byte)
-		buf := make([]byte, 3)
-		if _, err := s.ReadFull(buf); err != nil {
-			return err
-		}
+	// id3v2FlagFooter is the ID3v2.4 header flag indicating that a footer follows the tag.
+	id3v2FlagFooter = 0x10
+
+	// maxID3v2Size is the maximum size of an ID3v2 tag kept for the metadata.
+	// A larger tag is skipped without being kept.
+	maxID3v2Size = 16 << 20
+
+	// maxTags is the maximum number of the tags recorded at the beginning of the stream.
+	// More tags are skipped without being recorded.
+	maxTags = 1024
 
-		buf = make([]byte, 4)
+	// maxConsecutiveTags is the maximum number of the consecutive tags skipped at the beginning of the stream.
+	// The rest is treated as the MPEG audio data, where garbage is skipped until a frame is found.
+	maxConsecutiveTags = 1024
+)
+
+func (s *source) skipTags() error {
+	// afterID3v2 is true after an ID3v2 tag. Only ID3v2 tags are skipped after an ID3v2 tag since some taggers
+	// prepend a new tag without removing the old one.
+	afterID3v2 := false
+	for i := 0; i < maxConsecutiveTags; i++ {
+		buf := make([]byte, 3)
 		n, err := s.ReadFull(buf)
-		if err != nil {
+		if afterID3v2 {
+			if err != nil && err != io.EOF {
+				return err
+			}
+			if string(buf[:n]) != "ID3" {
+				s.Unread(buf[:n])
+				return nil
+			}
+		} else if err != nil {
 			return err
 		}
-		if n != 4 {
+
+		switch string(buf) {
+		case "RIF":
+			if err := s.skipRIFF(); err != nil {
+				return err
+			}
+			if s.end == 0 {
+				return nil
+			}
+			// The MPEG audio data might start with tags.
+
+		case "TAG":
+			offset := s.pos - 3
+			tag := make([]byte, id3v1Size)
+			copy(tag, buf)
+			if _, err := s.ReadFull(tag[3:]); err != nil {
+				return err
+			}
+			s.addTag(tagBlock{format: TagFormatID3v1, offset: offset, size: id3v1Size, data: tag})
+			return nil
+
+		case "ID3":
+			if err := s.skipID3v2(); err != nil {
+				return err
+			}
+			afterID3v2 = true
+
+		default:
+			s.Unread(buf)
 			return nil
 		}
-		size := (uint32(buf[0]) << 21) | (uint32(buf[1]) << 14) |
-			(uint32(buf[2]) << 7) | uint32(buf[3])
-		buf = make([]byte, size)
-		if _, err := s.ReadFull(buf); err != nil {
+	}
+	return nil
+}
+
+// skipID3v2 skips an ID3v2 tag whose first 3 bytes "ID3" are already read.
+func (s *source) skipID3v2() error {
+	offset := s.pos - 3
+	// Read the rest of the header: version (2 bytes), flags (1 byte) and size (4 bytes).
+	header := make([]byte, id3v2HeaderSize)
+	copy(header, "ID3")
+	if _, err := s.ReadFull(header[3:]); err != nil {
+		return err
+	}
+	// The size excludes the header and the footer, and includes the extended header.
+	size := (int(header[6]) << 21) | (int(header[7]) << 14) | (int(header[8]) << 7) | int(header[9])
+	if header[3] >= 4 && header[5]&id3v2FlagFooter != 0 {
+		size += id3v2HeaderSize
+	}
+	if id3v2HeaderSize+size > maxID3v2Size {
+		// Don't allocate a huge buffer for the declared size, which might be hostile.
+		if err := s.discard(int64(size)); err != nil {
 			return err
 		}
-
-	default:
-		s.Unread(buf)
+		s.addTag(tagBlock{format: TagFormatID3v2, offset: offset, size: int64(id3v2HeaderSize + size)})
+		return nil
+	}
+	// Keep the whole tag including its header for the metadata.
+	body, err := s.readBytes(size)
+	if err != nil {
+		return err
+	}
+	tag := append(header, body...)
+	s.addTag(tagBlock{format: TagFormatID3v2, offset: offset, size: int64(len(tag)), data: tag})
+	// Only the first tag is used for the metadata when multiple tags precede the audio data.
+	if s.id3v2 == nil {
+		s.id3v2 = tag
 	}
-
 	return nil
 }
 
+// addTag records the tag b unless b is already recorded, e.g. when the tags are skipped again after rewinding.
+func (s *source) addTag(b tagBlock) {
+	if len(s.tags) >= maxTags {
+		return
+	}
+	// The tags are recorded in the order of their offsets, so a tag not after the last one is already recorded.
+	if n := len(s.tags); n > 0 && b.offset <= s.tags[n-1].offset {
+		return
+	}
+	s.tags = append(s.tags, b)
+}
+
 func (s *source) rewind() error {
 	if _, err := s.Seek(0, io.SeekStart); err != nil {
 		return err
 	}
 	s.pos = 0
-	s.buf = nil
 	return nil
 }
 
 func (s *source) Unread(buf []byte) {
-	s.buf = append(s.buf, buf...)
+	// The unread bytes precede the bytes already buffered. Usually, they are the bytes just read from the buffer.
+	if len(buf) <= s.r {
+		s.r -= len(buf)
+		copy(s.buf[s.r:], buf)
+	} else {
+		b := make([]byte, len(buf)+s.w-s.r+readAheadSize)
+		copy(b, buf)
+		s.w = len(buf) + copy(b[len(buf):], s.buf[s.r:s.w])
+		s.r = 0
+		s.buf = b
+	}
 	s.pos -= int64(len(buf))
 }
 
+// readAheadSize is the size of a read from the reader, so that the small reads like frame headers don't hit the
+// reader.
+const readAheadSize = 4096
+
+// fill reads ahead from the reader so that at least n bytes are buffered.
+// fill buffers fewer bytes at the end of the stream.
+func (s *source) fill(n int) error {
+	if s.w-s.r >= n {
+		return nil
+	}
+	if len(s.buf)-s.r < n {
+		b := s.buf
+		if len(b) < n {
+			size := readAheadSize
+			if size < n {
+				size = n
+			}
+			b = make([]byte, size)
+		}
+		s.w = copy(b, s.buf[s.r:s.w])
+		s.r = 0
+		s.buf = b
+	}
+	m, err := io.ReadAtLeast(s.reader, s.buf[s.w:], n-(s.w-s.r))
+	s.w += m
+	if err != nil {
+		// Allow if all data can't be read. This is common.
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		if err != io.EOF {
+			err = &SourceError{Op: "read", Err: err}
+		}
+	}
+	return err
+}
+
+const (
+	// id3v1Size is the size of an ID3v1 tag.
+	id3v1Size = 128
+
+	// apeFooterSize is the size of an APE tag footer and header.
+	apeFooterSize = 32
+
+	// apeFlagHasHeader is the APE tag flag indicating that the tag has a header.
+	apeFlagHasHeader = 1 << 31
+
+	// apeFlagIsHeader is the APE tag flag indicating that the 32 bytes are the header.
+	apeFlagIsHeader = 1 << 29
+)
+
+// peek returns the next n bytes without consuming them.
+// peek returns fewer bytes at the end of the stream or at the end of the audio data.
+// The returned bytes are valid until the next read.
+func (s *source) peek(n int) ([]byte, error) {
+	if s.end > 0 && s.pos+int64(n) > s.end {
+		n = int(s.end - s.pos)
+		if n < 0 {
+			n = 0
+		}
+	}
+	if err := s.fill(n); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if n > s.w-s.r {
+		n = s.w - s.r
+	}
+	return s.buf[s.r : s.r+n], nil
+}
+
+// atEndTags reports whether s is at the tags at the end of the stream, which are an APE tag with a header and an
+// ID3v1 tag. The tags are consumed and kept.
+//
+// If an APE tag is not followed by an ID3v1 tag or the end of the stream, atEndTags returns false with the APE tag
+// consumed so that the decoding continues after the tag. Otherwise, the position of s is not changed.
+func (s *source) atEndTags() (bool, error) {
+	p, err := s.peek(3)
+	if err != nil {
+		return false, err
+	}
+	switch string(p) {
+	case "APE":
+		ok, err := s.skipAPE()
+		if err != nil || !ok {
+			return false, err
+		}
+		p, err := s.peek(3)
+		if err != nil {
+			return false, err
+		}
+		if len(p) == 0 {
+			return true, nil
+		}
+		if string(p) == "TAG" {
+			return s.atID3v1()
+		}
+		return false, nil
+	case "TAG":
+		return s.atID3v1()
+	}
+	return false, nil
+}
+
+// skipAPE skips an APE tag with a header. If s is not at such a tag, the position of s is not changed.
+// APE tags without headers like APEv1 tags are not detected.
+func (s *source) skipAPE() (bool, error) {
+	offset := s.pos
+	h, err := s.peek(apeFooterSize)
+	if err != nil {
+		return false, err
+	}
+	if len(h) < apeFooterSize || string(h[:8]) != "APETAGEX" || binary.LittleEndian.Uint32(h[20:24])&apeFlagIsHeader == 0 {
+		return false, nil
+	}
+	// The size includes the footer and excludes the header.
+	size := int64(binary.LittleEndian.Uint32(h[12:16]))
+	if size < apeFooterSize {
+		return false, nil
+	}
+	if apeFooterSize+size > maxID3v2Size {
+		// Don't allocate a huge buffer for the declared size, which might be hostile.
+		if err := s.discard(apeFooterSize + size); err != nil && err != io.EOF {
+			return false, err
+		}
+		s.addTrailing(tagBlock{format: TagFormatAPE, offset: offset, size: apeFooterSize + size})
+		return true, nil
+	}
+	tag, err := s.readBytes(int(apeFooterSize + size))
+	if err != nil {
+		if err == io.EOF {
+			// The tag is truncated at the end of the stream.
+			return true, nil
+		}
+		return false, err
+	}
+	s.addTrailing(tagBlock{format: TagFormatAPE, offset: offset, size: int64(len(tag)), data: tag})
+	return true, nil
+}
+
+// atID3v1 reports whether s is at an ID3v1 tag at the end of the stream.
+// If so, the tag is consumed and kept. Otherwise, the position of s is not changed.
+func (s *source) atID3v1() (bool, error) {
+	offset := s.pos
+	// Read one more byte to check that the tag is at the end.
+	tag, err := s.peek(id3v1Size + 1)
+	if err != nil {
+		return false, err
+	}
+	if len(tag) != id3v1Size || string(tag[:3]) != "TAG" {
+		return false, nil
+	}
+	tag = make([]byte, id3v1Size)
+	if _, err := s.ReadFull(tag); err != nil {
+		return false, err
+	}
+	s.addTrailing(tagBlock{format: TagFormatID3v1, offset: offset, size: id3v1Size, data: tag})
+	return true, nil
+}
+
+// addTrailing records the tag b at the end of the stream unless b is already recorded, e.g. when the stream is read
+// to the end again after the scan.
+func (s *source) addTrailing(b tagBlock) {
+	for _, t := range s.trailing {
+		if t.offset == b.offset {
+			return
+		}
+	}
+	s.trailing = append(s.trailing, b)
+}
+
 func (s *source) ReadFull(buf []byte) (int, error) {
-	read := 0
-	if s.buf != nil {
-		read = copy(buf, s.buf)
-		if len(s.buf) > read {
-			s.buf = s.buf[read:]
-		} else {
-			s.buf = nil
+	if s.end > 0 && s.pos+int64(len(buf)) > s.end {
+		n := s.end - s.pos
+		if n <= 0 {
+			// s is at or beyond the end, e.g. after seeking to the end of the source.
+			return 0, io.EOF
 		}
-		if len(buf) == read {
-			return read, nil
+		m, err := s.ReadFull(buf[:n])
+		if err == nil {
+			err = io.EOF
 		}
+		return m, err
+	}
+
+	read := copy(buf, s.buf[s.r:s.w])
+	s.r += read
+	s.pos += int64(read)
+	if len(buf) == read {
+		return read, nil
+	}
+
+	// A small read is served from the buffer read ahead.
+	if rest := len(buf) - read; rest < readAheadSize {
+		err := s.fill(rest)
+		n := copy(buf[read:], s.buf[s.r:s.w])
+		s.r += n
+		s.pos += int64(n)
+		if n == rest {
+			err = nil
+		}
+		return read + n, err
 	}
 
 	n, err := io.ReadFull(s.reader, buf[read:])
@@ -114,7 +430,83 @@ func (s *source) ReadFull(buf []byte) (int, error) {
 		if err == io.ErrUnexpectedEOF {
 			err = io.EOF
 		}
+		if err != io.EOF {
+			err = &SourceError{Op: "read", Err: err}
+		}
 	}
 	s.pos += int64(n)
 	return n + read, err
 }
+
+// readBytes reads n bytes.
+// readBytes allocates the buffer gradually so that a huge n in a short stream doesn't allocate a huge buffer.
+func (s *source) readBytes(n int) ([]byte, error) {
+	const chunkSize = 64 * 1024
+	var buf []byte
+	for len(buf) < n {
+		c := n - len(buf)
+		if c > chunkSize {
+			c = chunkSize
+		}
+		buf = append(buf, make([]byte, c)...)
+		if _, err := s.ReadFull(buf[len(buf)-c:]); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// discard reads and discards n bytes.
+// The bytes are skipped in the buffer read ahead without being copied.
+func (s *source) discard(n int64) error {
+	var eof error
+	if s.end > 0 && s.pos+n > s.end {
+		n = s.end - s.pos
+		if n < 0 {
+			n = 0
+		}
+		eof = io.EOF
+	}
+	for n > 0 {
+		if s.r == s.w {
+			c := readAheadSize
+			if int64(c) > n {
+				c = int(n)
+			}
+			if err := s.fill(c); s.r == s.w {
+				if err == nil {
+					err = io.EOF
+				}
+				return err
+			}
+		}
+		m := int64(s.w - s.r)
+		if m > n {
+			m = n
+		}
+		s.r += int(m)
+		s.pos += m
+		n -= m
+	}
+	return eof
+}
+
+// sourceSize returns the size of r.
+// If r doesn't have Size, sourceSize seeks to the end and restores the position.
+func sourceSize(seeker io.Seeker) (int64, error) {
+	if s, ok := seeker.(interface{ Size() int64 }); ok {
+		return s.Size(), nil
+	}
+	cur, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, &SourceError{Op: "seek", Err: err}
+	}
+	size, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, &SourceError{Op: "seek", Err: err}
+	}
+	if _, err := seeker.Seek(cur, io.SeekStart); err != nil {
+		return 0, &SourceError{Op: "seek", Err: err}
+	}
+	return size, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Availability reports which bytes of a partially available source, like a file being downloaded, are present.
+type Availability interface {
+	// Run reports whether the byte at offset is available, and returns the end offset (exclusive) of the run of
+	// bytes that share the same availability.
+	Run(offset int64) (available bool, end int64)
+}
+
+// ByteRanges is an Availability of the given ranges. The ranges must be sorted by Offset and must not overlap.
+type ByteRanges []ByteRange
+
+// Run implements Availability.
+func (b ByteRanges) Run(offset int64) (bool, int64) {
+	i := sort.Search(len(b), func(i int) bool {
+		return offset < b[i].Offset+b[i].Length
+	})
+	if i == len(b) {
+		return false, 1<<63 - 1
+	}
+	if offset < b[i].Offset {
+		return false, b[i].Offset
+	}
+	return true, b[i].Offset + b[i].Length
+}
+
+// sparseReader is an io.Reader over an io.ReaderAt that skips missing bytes.
+type sparseReader struct {
+	r            io.ReaderAt
+	size         int64
+	availability Availability
+	pos          int64
+
+	// gaps are the missing ranges skipped and not taken by takeGaps yet.
+	gaps []ByteRange
+}
+
+func (s *sparseReader) Read(buf []byte) (int, error) {
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	for s.pos < s.size {
+		available, end := s.availability.Run(s.pos)
+		if end <= s.pos {
+			return 0, fmt.Errorf("mp3: Availability.Run(%d) returned the end %d not after the offset", s.pos, end)
+		}
+		if end > s.size {
+			end = s.size
+		}
+		if !available {
+			s.gaps = append(s.gaps, ByteRange{
+				Offset: s.pos,
+				Length: end - s.pos,
+			})
+			s.pos = end
+			continue
+		}
+		if int64(len(buf)) > end-s.pos {
+			buf = buf[:end-s.pos]
+		}
+		n, err := s.r.ReadAt(buf, s.pos)
+		s.pos += int64(n)
+		if err == io.EOF && n > 0 {
+			err = nil
+		}
+		return n, err
+	}
+	return 0, io.EOF
+}
+
+// takeGaps returns the missing ranges before the offset and forgets them.
+// The ranges after the offset are kept as they are skipped while reading ahead.
+func (s *sparseReader) takeGaps(offset int64) []ByteRange {
+	var gaps []ByteRange
+	for len(s.gaps) > 0 && s.gaps[0].Offset < offset {
+		gaps = append(gaps, s.gaps[0])
+		s.gaps = s.gaps[1:]
+	}
+	return gaps
+}
+
+// NewSparseDecoder returns a decoder for a partially available source like a file being downloaded.
+//
+// r is the source of size bytes, and availability reports which bytes of r are present.
+// Missing bytes are never read.
+// Frames that are not fully present are decoded as silence, and missing ranges are replaced with silence of the
+// estimated duration. DecoderOptions.OnGap is called for each missing range.
+//
+// The returned decoder is not seekable and its Length is -1.
+func NewSparseDecoder(r io.ReaderAt, size int64, availability Availability, options *DecoderOptions) (*Decoder, error) {
+	return NewDecoderWithOptions(&sparseReader{
+		r:            r,
+		size:         size,
+		availability: availability,
+	}, options)
+}
+
+// handleGaps replaces the current frame with silence when missing bytes were skipped while reading the frame.
+// The silence for the missing frames is output by readSilentFrame before the next frame.
+func (d *Decoder) handleGaps(pcm []byte) []byte {
+	sr, ok := d.source.reader.(*sparseReader)
+	if !ok {
+		return pcm
+	}
+	gaps := sr.takeGaps(d.source.pos)
+	if len(gaps) == 0 {
+		return pcm
+	}
+
+	framesize, err := d.frame.FrameSize()
+	if err != nil || framesize <= 0 {
+		framesize = 1
+	}
+	for _, g := range gaps {
+		if d.onGap != nil {
+			d.onGap(g)
+		}
+		d.silentFrames += int((g.Length + int64(framesize)/2) / int64(framesize))
+	}
+	d.silentFrameBytes = len(pcm)
+	// The current frame itself is also silent since its bytes or its bit reservoir were lost.
+	for i := range pcm {
+		pcm[i] = 0
+	}
+	return pcm
+}
+
+// readSilentFrame outputs a frame of silence for a missing frame.
+func (d *Decoder) readSilentFrame() error {
+	d.silentFrames--
+	pcm := d.pcm[:0]
+	for i := 0; i < d.silentFrameBytes; i++ {
+		pcm = append(pcm, 0)
+	}
+	d.pcm = pcm
+	return d.appendPCM(pcm, d.source.pos)
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestSparseDecoder(t *testing.T) {
+	const bytesPerFrame = 576 * 4
+
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	buf, err := ioutil.ReadFile("example/mpeg2.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := int64(len(buf))
+	missing := mp3.ByteRange{
+		Offset: 100000,
+		Length: 20000,
+	}
+	// Make sure the missing bytes are never read.
+	src := make([]byte, len(buf))
+	copy(src, buf)
+	for i := missing.Offset; i < missing.Offset+missing.Length; i++ {
+		src[i] = 0xff
+	}
+
+	var gaps []mp3.ByteRange
+	d, err := mp3.NewSparseDecoder(bytes.NewReader(src), size, mp3.ByteRanges{
+		{Offset: 0, Length: missing.Offset},
+		{Offset: missing.Offset + missing.Length, Length: size - missing.Offset - missing.Length},
+	}, &mp3.DecoderOptions{
+		OnGap: func(r mp3.ByteRange) {
+			gaps = append(gaps, r)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gaps) != 1 || gaps[0] != missing {
+		t.Errorf("gaps: got: %v, want: %v", gaps, []mp3.ByteRange{missing})
+	}
+	if diff := len(got) - len(want); diff < -2*bytesPerFrame || diff > 2*bytesPerFrame {
+		t.Errorf("length: got: %d, want: %d (+/- 2 frames)", len(got), len(want))
+	}
+	if !bytes.Equal(got[:bytesPerFrame*10], want[:bytesPerFrame*10]) {
+		t.Errorf("decoded PCM before the gap doesn't match")
+	}
+}
+
+func TestSparseDecoderLargeGap(t *testing.T) {
+	const bytesPerFrame = 576 * 4
+
+	src := mustReadFile(t, "example/mpeg2.mp3")
+	size := int64(len(src))
+	// The silence for the missing frames is output frame by frame, and is not buffered at once.
+	d, err := mp3.NewSparseDecoder(bytes.NewReader(src), size, mp3.ByteRanges{
+		{Offset: 0, Length: 10000},
+		{Offset: size - 10000, Length: 10000},
+	}, &mp3.DecoderOptions{
+		MemoryLimit: mp3.MemoryLimit{
+			MaxBufferedBytes: bytesPerFrame,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The duration of the gap is estimated by the frame size.
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	if diff := len(got) - len(want); diff < -len(want)/100 || diff > len(want)/100 {
+		t.Errorf("length: got: %d, want: %d (+/- 1%%)", len(got), len(want))
+	}
+}
+
+type stuckAvailability struct {
+	available int64
+}
+
+func (s stuckAvailability) Run(offset int64) (bool, int64) {
+	if offset < s.available {
+		return true, s.available
+	}
+	// A wrong end that doesn't advance.
+	return false, offset
+}
+
+func TestSparseDecoderInvalidAvailability(t *testing.T) {
+	src := mustReadFile(t, "example/mpeg2.mp3")
+	d, err := mp3.NewSparseDecoder(bytes.NewReader(src), int64(len(src)), stuckAvailability{available: 10000}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err == nil {
+		t.Errorf("ReadAll must fail with an invalid Availability")
+	}
+}
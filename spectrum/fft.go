@@ -0,0 +1,81 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectrum
+
+import (
+	"math"
+)
+
+// fft is a radix-2 fast Fourier transform of a fixed size.
+type fft struct {
+	// cos and sin are the twiddle factors.
+	cos []float64
+	sin []float64
+
+	// reversed[i] is i with the bits reversed.
+	reversed []int
+}
+
+// newFFT returns an fft of the size, which must be a power of two.
+func newFFT(size int) *fft {
+	f := &fft{
+		cos:      make([]float64, size/2),
+		sin:      make([]float64, size/2),
+		reversed: make([]int, size),
+	}
+	for i := range f.cos {
+		f.cos[i] = math.Cos(2 * math.Pi * float64(i) / float64(size))
+		f.sin[i] = -math.Sin(2 * math.Pi * float64(i) / float64(size))
+	}
+	bits := 0
+	for 1<<uint(bits) < size {
+		bits++
+	}
+	for i := range f.reversed {
+		r := 0
+		for b := 0; b < bits; b++ {
+			if i&(1<<uint(b)) != 0 {
+				r |= 1 << uint(bits-1-b)
+			}
+		}
+		f.reversed[i] = r
+	}
+	return f
+}
+
+// transform transforms the complex values of re and im in place.
+func (f *fft) transform(re, im []float64) {
+	n := len(re)
+	for i, j := range f.reversed {
+		if i < j {
+			re[i], re[j] = re[j], re[i]
+			im[i], im[j] = im[j], im[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		step := n / size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				c, s := f.cos[k*step], f.sin[k*step]
+				i, j := start+k, start+k+half
+				tr := re[j]*c - im[j]*s
+				ti := re[j]*s + im[j]*c
+				re[j], im[j] = re[i]-tr, im[i]-ti
+				re[i], im[i] = re[i]+tr, im[i]+ti
+			}
+		}
+	}
+}
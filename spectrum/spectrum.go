@@ -0,0 +1,200 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spectrum computes magnitude spectra of 16-bit little-endian 2-channel PCM, which is the format of the
+// decoded stream of mp3.Decoder, for visualizers.
+//
+// An Analyzer reads PCM from a reader, and returns the spectrum of a window of the PCM at every hop:
+//
+//	a, err := spectrum.NewAnalyzer(d, nil)
+//	...
+//	for {
+//		mags, err := a.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+package spectrum
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// DefaultSize is the default number of the samples in a window.
+const DefaultSize = 2048
+
+// inputBufferSize is the size of the buffer to read the source PCM in bytes.
+const inputBufferSize = 4096
+
+// Options represents options for an Analyzer.
+type Options struct {
+	// Size is the number of the samples in a window, which must be a power of two.
+	// The frequency resolution is the sample rate divided by Size.
+	// If Size is 0, DefaultSize is used.
+	Size int
+
+	// Hop is the number of the samples between the starts of two windows.
+	// If Hop is 0, a half of Size is used.
+	Hop int
+}
+
+// An Analyzer computes the magnitude spectra of PCM read from a reader.
+//
+// The two channels are mixed to mono. The samples are multiplied by a Hann window before the FFT.
+type Analyzer struct {
+	src  io.Reader
+	size int
+	hop  int
+
+	window []float64
+	// scale normalizes the magnitudes so that a full-scale sine wave is 1.
+	scale float64
+
+	// samples is the mono samples from the start of the next window.
+	samples []float64
+
+	// skip is the number of the samples to skip before the next window when Hop is larger than Size.
+	skip int
+
+	buf  []byte
+	rest int
+	eof  bool
+
+	fft        *fft
+	re         []float64
+	im         []float64
+	magnitudes []float32
+}
+
+// NewAnalyzer returns a new Analyzer reading PCM from src.
+//
+// options can be nil. A nil options is the same as the default options.
+func NewAnalyzer(src io.Reader, options *Options) (*Analyzer, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	size := options.Size
+	if size == 0 {
+		size = DefaultSize
+	}
+	if size < 2 || size&(size-1) != 0 {
+		return nil, fmt.Errorf("spectrum: the size must be a power of two: %d", size)
+	}
+	hop := options.Hop
+	if hop == 0 {
+		hop = size / 2
+	}
+	if hop < 0 {
+		return nil, fmt.Errorf("spectrum: invalid hop: %d", hop)
+	}
+
+	a := &Analyzer{
+		src:        src,
+		size:       size,
+		hop:        hop,
+		window:     make([]float64, size),
+		samples:    make([]float64, 0, size),
+		buf:        make([]byte, inputBufferSize),
+		fft:        newFFT(size),
+		re:         make([]float64, size),
+		im:         make([]float64, size),
+		magnitudes: make([]float32, size/2+1),
+	}
+	var sum float64
+	for i := range a.window {
+		w := 0.5 * (1 - math.Cos(2*math.Pi*float64(i)/float64(size)))
+		a.window[i] = w
+		sum += w
+	}
+	// A sine wave of the amplitude 1 has the magnitude of sum/2 at its frequency.
+	a.scale = 2 / sum
+	return a, nil
+}
+
+// Size returns the number of the samples in a window.
+func (a *Analyzer) Size() int {
+	return a.size
+}
+
+// BinFrequency returns the center frequency in Hz of the bin of a spectrum for the sample rate.
+func (a *Analyzer) BinFrequency(bin int, sampleRate int) float64 {
+	return float64(bin) * float64(sampleRate) / float64(a.size)
+}
+
+// Next returns the magnitude spectrum of the next window. The spectrum has Size/2+1 bins from 0 Hz to the Nyquist
+// frequency. The magnitudes are linear where a full-scale sine wave is 1.
+//
+// The returned slice is reused by the next call of Next.
+//
+// The windows start at every hop while the start is before the end of the PCM. The samples after the end are
+// zero. Next returns io.EOF when there are no more windows.
+func (a *Analyzer) Next() ([]float32, error) {
+	for !a.eof && len(a.samples) < a.size {
+		if err := a.fill(); err != nil {
+			return nil, err
+		}
+	}
+	if len(a.samples) == 0 {
+		return nil, io.EOF
+	}
+
+	for i := range a.re {
+		var v float64
+		if i < len(a.samples) {
+			v = a.samples[i]
+		}
+		a.re[i] = v * a.window[i]
+		a.im[i] = 0
+	}
+	a.fft.transform(a.re, a.im)
+	for i := range a.magnitudes {
+		a.magnitudes[i] = float32(math.Hypot(a.re[i], a.im[i]) * a.scale)
+	}
+	// The DC and the Nyquist bins don't have the mirrored half.
+	a.magnitudes[0] /= 2
+	a.magnitudes[len(a.magnitudes)-1] /= 2
+
+	if a.hop < len(a.samples) {
+		a.samples = append(a.samples[:0], a.samples[a.hop:]...)
+	} else {
+		a.skip = a.hop - len(a.samples)
+		a.samples = a.samples[:0]
+	}
+	return a.magnitudes, nil
+}
+
+// fill reads the source and appends the samples.
+func (a *Analyzer) fill() error {
+	n, err := a.src.Read(a.buf[a.rest:])
+	n += a.rest
+	aligned := n &^ 3
+	for i := 0; i < aligned; i += 4 {
+		if a.skip > 0 {
+			a.skip--
+			continue
+		}
+		l := int16(uint16(a.buf[i]) | uint16(a.buf[i+1])<<8)
+		r := int16(uint16(a.buf[i+2]) | uint16(a.buf[i+3])<<8)
+		a.samples = append(a.samples, (float64(l)+float64(r))/2/32768)
+	}
+	a.rest = copy(a.buf, a.buf[aligned:n])
+	if err == io.EOF {
+		a.eof = true
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectrum_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/spectrum"
+)
+
+// sine returns n samples of a sine wave of the frequency and the amplitude at the sample rate.
+func sine(freq, amplitude float64, sampleRate, n int) []byte {
+	pcm := make([]byte, n*4)
+	for i := 0; i < n; i++ {
+		v := int16(math.Round(32767 * amplitude * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate))))
+		binary.LittleEndian.PutUint16(pcm[4*i:], uint16(v))
+		binary.LittleEndian.PutUint16(pcm[4*i+2:], uint16(v))
+	}
+	return pcm
+}
+
+func TestAnalyzer(t *testing.T) {
+	const (
+		sampleRate = 44100
+		size       = 2048
+		bin        = 100
+		n          = 10000
+	)
+	a, err := NewAnalyzer(bytes.NewReader(sine(bin*sampleRate/float64(size), 0.5, sampleRate, n)), &Options{
+		Size: size,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := a.BinFrequency(bin, sampleRate), float64(bin*sampleRate)/size; got != want {
+		t.Errorf("BinFrequency: got: %f, want: %f", got, want)
+	}
+
+	var windows int
+	for {
+		mags, err := a.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(mags), size/2+1; got != want {
+			t.Fatalf("len(mags): got: %d, want: %d", got, want)
+		}
+		windows++
+		// The last windows are padded with zeros.
+		if (windows-1)*size/2+size > n {
+			continue
+		}
+		if got, want := mags[bin], float32(0.5); math.Abs(float64(got-want)) > 0.001 {
+			t.Errorf("window %d: the magnitude at the frequency: got: %f, want: %f", windows, got, want)
+		}
+		// The Hann window spreads the sine wave to the next bins by a half.
+		if got, want := mags[bin+1], float32(0.25); math.Abs(float64(got-want)) > 0.001 {
+			t.Errorf("window %d: the magnitude at the next bin: got: %f, want: %f", windows, got, want)
+		}
+		if got := mags[bin+10]; got > 0.001 {
+			t.Errorf("window %d: the magnitude at a far bin: got: %f, want: 0", windows, got)
+		}
+	}
+	// The windows start at every hop before the end.
+	if got, want := windows, (n+size/2-1)/(size/2); got != want {
+		t.Errorf("windows: got: %d, want: %d", got, want)
+	}
+}
+
+func TestAnalyzerDC(t *testing.T) {
+	pcm := make([]byte, 256*4)
+	for i := 0; i < len(pcm); i += 2 {
+		binary.LittleEndian.PutUint16(pcm[i:], 16384)
+	}
+	a, err := NewAnalyzer(bytes.NewReader(pcm), &Options{
+		Size: 256,
+		Hop:  1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mags, err := a.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := mags[0], float32(0.5); math.Abs(float64(got-want)) > 0.001 {
+		t.Errorf("the magnitude at 0 Hz: got: %f, want: %f", got, want)
+	}
+	// The hop is longer than the PCM.
+	if _, err := a.Next(); err != io.EOF {
+		t.Errorf("Next: got: %v, want: %v", err, io.EOF)
+	}
+}
+
+func TestNewAnalyzerInvalidSize(t *testing.T) {
+	if _, err := NewAnalyzer(bytes.NewReader(nil), &Options{Size: 1000}); err == nil {
+		t.Errorf("NewAnalyzer with a size of non-power of two must fail")
+	}
+}
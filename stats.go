@@ -0,0 +1,176 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrCRCMismatch is the error when the CRC of a frame doesn't match with CRCMismatchError.
+var ErrCRCMismatch = errors.New("mp3: CRC mismatch")
+
+// Stats represents statistics of the frames read by a Decoder.
+type Stats struct {
+	// Frames is the number of frames read so far. The frames read to seek are not counted.
+	Frames int64
+
+	// CRCErrors is the number of frames whose CRC doesn't match.
+	CRCErrors int64
+
+	// BadFrames is the number of frames skipped or replaced with silence due to errors by the FrameError policy.
+	// The bad frames are also counted in Frames.
+	BadFrames int64
+}
+
+// Stats returns the statistics of the frames read so far.
+func (d *Decoder) Stats() Stats {
+	return d.stats
+}
+
+// skipBadFrame counts a frame that can't be decoded due to err and handles it by the policy.
+// start is the position of the source before the frame is read, and pos is the position of the frame.
+// skipBadFrame returns false when the error should be returned.
+// When skipBadFrame returns true, the caller should output badFramePCM for the frame.
+func (d *Decoder) skipBadFrame(err error, start int64, pos int64) bool {
+	if d.frameError == FrameErrorAbort || d.strict {
+		return false
+	}
+	// Skipping the frame would continue scanning beyond the limit.
+	if errors.Is(err, ErrNoSync) {
+		return false
+	}
+	// The memory limit is a hard limit regardless of the policy.
+	if errors.Is(err, ErrMemoryLimit) {
+		return false
+	}
+	// An error of the source is not a problem of the frame. Return it so that the caller can retry.
+	if isSourceError(err) {
+		return false
+	}
+	// If nothing is consumed, e.g. due to an I/O error, the same error would happen forever.
+	if d.source.pos <= start {
+		return false
+	}
+	if err := d.resync(pos); err != nil {
+		return false
+	}
+	d.stats.Frames++
+	d.stats.BadFrames++
+	d.badFrames++
+	d.warn(Warning{
+		Kind:   WarningConcealedFrame,
+		Offset: pos,
+		Err:    err,
+	})
+	return true
+}
+
+// isSourceError reports whether err is or wraps a *SourceError.
+// This is like errors.As, which is not used here as it links reflection and makes the mp3minimal binary larger.
+func isSourceError(err error) bool {
+	for err != nil {
+		if _, ok := err.(*SourceError); ok {
+			return true
+		}
+		err = errors.Unwrap(err)
+	}
+	return false
+}
+
+// badFramePCM returns the PCM to output for a bad frame by the policy.
+func (d *Decoder) badFramePCM() []byte {
+	switch d.frameError {
+	case FrameErrorSilence:
+		return make([]byte, d.badFrameSize())
+	case FrameErrorRepeat:
+		return d.repeatedPCM(d.badFrameSize())
+	}
+	return nil
+}
+
+// resync positions the source at the next frame after the bad frame at pos.
+//
+// The size of the bad frame is not reliable as its header might be corrupted, and then the following good frames
+// might be consumed as the bad frame's data. Thus, resync scans from the byte after the bad frame's header for a
+// frame header followed by another frame header.
+// resync does nothing when the source is not io.Seeker. In this case, the next frame header after the consumed
+// bytes is used.
+func (d *Decoder) resync(pos int64) error {
+	if _, ok := d.source.reader.(io.Seeker); !ok {
+		return nil
+	}
+	if _, err := d.source.Seek(pos+1, io.SeekStart); err != nil {
+		return err
+	}
+	return d.source.syncFrame()
+}
+
+// badFrameSize returns the number of bytes to output for a bad frame.
+func (d *Decoder) badFrameSize() int {
+	if d.frame != nil {
+		return d.frame.Header().BytesPerFrame()
+	}
+	return int(d.first.bytesPerFrame)
+}
+
+// repeatedPCM returns the PCM of the last good frame attenuated by the number of consecutive bad frames.
+// repeatedPCM returns silence if the last good frame doesn't have size bytes.
+func (d *Decoder) repeatedPCM(size int) []byte {
+	pcm := make([]byte, size)
+	if len(d.lastPCM) != size {
+		return pcm
+	}
+	// The first repetition is not attenuated.
+	shift := uint(d.badFrames - 1)
+	if shift >= 16 {
+		return pcm
+	}
+	for i := 0; i < size; i += 2 {
+		v := int16(d.lastPCM[i]) | int16(d.lastPCM[i+1])<<8
+		v >>= shift
+		pcm[i] = byte(v)
+		pcm[i+1] = byte(v >> 8)
+	}
+	return pcm
+}
+
+// checkCRC counts a CRC mismatch of the current frame and handles it by the policy.
+// checkCRC returns true when the frame should be concealed.
+// pos and index are the offset and the index of the frame.
+func (d *Decoder) checkCRC(pos int64, index int64) (bool, error) {
+	if !d.frame.CRCMismatch() {
+		return false, nil
+	}
+	d.stats.CRCErrors++
+	if d.onCRCError != nil {
+		d.onCRCError(pos)
+	}
+	if d.strict {
+		return false, d.positionError(ErrCRCMismatch, pos, index)
+	}
+	switch d.crcMismatch {
+	case CRCMismatchConceal:
+		d.warn(Warning{
+			Kind:   WarningConcealedFrame,
+			Offset: pos,
+			Err:    ErrCRCMismatch,
+		})
+		return true, nil
+	case CRCMismatchError:
+		return false, d.positionError(ErrCRCMismatch, pos, index)
+	}
+	return false, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// protectedFrames returns n silent MPEG-2 Layer III mono frames (64 kbps, 22050 Hz) protected by CRCs.
+// The CRCs of the frames at the indices in broken don't match.
+func protectedFrames(n int, broken ...int) []byte {
+	const size = 72 * 64000 / 22050
+	var buf []byte
+	for i := 0; i < n; i++ {
+		f := make([]byte, size)
+		binary.BigEndian.PutUint32(f, 0xfff280c4)
+		// The side information (9 bytes) is all zero.
+		crc := crc16(crc16(0xffff, f[2:4]), f[6:6+9])
+		for _, b := range broken {
+			if b == i {
+				crc ^= 0x1234
+			}
+		}
+		binary.BigEndian.PutUint16(f[4:], crc)
+		buf = append(buf, f...)
+	}
+	return buf
+}
+
+func crc16(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x8005
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func TestCRCMismatch(t *testing.T) {
+	buf := protectedFrames(10, 3, 7)
+
+	for _, policy := range []mp3.CRCMismatchPolicy{mp3.CRCMismatchIgnore, mp3.CRCMismatchConceal} {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+			CRCMismatch: policy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pcm, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(pcm), 10*576*4; got != want {
+			t.Errorf("decoded length with policy %d: got: %d, want: %d", policy, got, want)
+		}
+		if got, want := d.Stats(), (mp3.Stats{Frames: 10, CRCErrors: 2}); got != want {
+			t.Errorf("Stats with policy %d: got: %+v, want: %+v", policy, got, want)
+		}
+	}
+
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+		CRCMismatch: mp3.CRCMismatchError,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs int
+	var n int
+	for {
+		m, err := d.Read(make([]byte, 4096))
+		n += m
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !errors.Is(err, mp3.ErrCRCMismatch) {
+				t.Fatal(err)
+			}
+			errs++
+		}
+	}
+	if errs != 2 {
+		t.Errorf("the number of errors: got: %d, want: 2", errs)
+	}
+	if got, want := n, 8*576*4; got != want {
+		t.Errorf("decoded length: got: %d, want: %d", got, want)
+	}
+}
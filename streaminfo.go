@@ -0,0 +1,307 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/frameheader"
+	"github.com/hajimehoshi/go-mp3/internal/xing"
+)
+
+// StreamInfo represents information about a stream retrieved from its first frame.
+type StreamInfo struct {
+	// SampleRate is the sample rate like 44100.
+	SampleRate int
+
+	// Channels is the number of channels of the source stream.
+	// Note that the decoded stream is always 2 channels.
+	Channels int
+
+	// VBRHeader is "Xing", "Info" or "VBRI" when the first frame has a Xing, Info or VBRI header.
+	// Otherwise, VBRHeader is empty and the other fields from the header are -1 or nil.
+	//
+	// The frame of the header is not decoded.
+	VBRHeader string
+
+	// Frames is the number of audio frames in the VBR header.
+	// The frame of the header itself is not counted.
+	Frames int64
+
+	// Bytes is the size of the stream in bytes in the VBR header.
+	Bytes int64
+
+	// TOC is the seek table in the Xing or Info header. The seek table of a VBRI header is not available.
+	// TOC[i] is the position at i percent of the duration, in 1/256 of Bytes.
+	TOC []byte
+
+	// Quality is the VBR quality indicator from 0 (best) to 100 (worst) in the VBR header.
+	// Note that LAME writes 100 - 10*V - q where V is the VBR quality and q is the algorithm quality, which is larger
+	// for better quality. See LAMETag for the decoded values.
+	Quality int
+
+	// Encoder is the encoder identification like "LAME3.100" or "Lavc58.35" in the LAME tag, or empty if unknown.
+	Encoder string
+
+	// LAME is the LAME tag following the Xing or Info header, or nil if absent.
+	LAME *LAMETag
+}
+
+// LAMETag represents a LAME tag, which is an extension of a Xing or Info header written by LAME and other encoders.
+type LAMETag struct {
+	// Encoder is the encoder version like "LAME3.100".
+	Encoder string
+
+	// VBRMethod is the VBR method. 1 is CBR, 2 is ABR and 3-6 are VBR methods.
+	VBRMethod int
+
+	// Lowpass is the lowpass filter frequency in Hz, or 0 if unknown.
+	Lowpass int
+
+	// Bitrate is the bitrate in kbps: the target bitrate for ABR, the bitrate for CBR or the minimum bitrate for VBR.
+	// 255 means 255 kbps or more. 0 means unknown.
+	Bitrate int
+
+	// Preset is the preset value like 1000 for V0 in the recent LAME versions, or 0 if unknown.
+	Preset int
+
+	// VBRQuality is the VBR quality from 0 (best) to 9 like -V 2 of LAME, and AlgorithmQuality is the quality of the
+	// encoding algorithm from 0 (best) to 9 like -q 2 of LAME. They are decoded from Quality of the Xing header for
+	// the LAME encoder. They are -1 if unknown.
+	VBRQuality       int
+	AlgorithmQuality int
+
+	// Peak is the peak signal amplitude where 1 is the full scale, or 0 if unknown.
+	Peak float64
+
+	// TrackGain is the track ReplayGain adjustment in dB. TrackGain is valid only when HasTrackGain is true.
+	TrackGain    float64
+	HasTrackGain bool
+
+	// AlbumGain is the album ReplayGain adjustment in dB. AlbumGain is valid only when HasAlbumGain is true.
+	AlbumGain    float64
+	HasAlbumGain bool
+
+	// EncoderDelay is the number of samples per channel the encoder added at the beginning.
+	// This doesn't include the decoder delay of 529 samples.
+	EncoderDelay int
+
+	// EncoderPadding is the number of samples per channel the encoder added at the end.
+	EncoderPadding int
+}
+
+// StreamInfo returns the information about the stream.
+func (d *Decoder) StreamInfo() StreamInfo {
+	return d.info
+}
+
+// firstFrame represents the first frame of a stream.
+type firstFrame struct {
+	// start is the position of the first frame in the source.
+	start int64
+
+	// bytesPerFrame is the number of decoded bytes of the first frame.
+	bytesPerFrame int64
+
+	// metadata reports whether the first frame is a Xing, Info or VBRI frame.
+	// Such a frame is not decoded and is not counted in the length.
+	metadata bool
+}
+
+// readStreamInfo reads the first frame of s and returns the information about the stream.
+// The read frame is consumed if it is a Xing, Info or VBRI frame. Otherwise, the read frame is unread so that it
+// is decoded as usual.
+func readStreamInfo(s *source) (StreamInfo, firstFrame, error) {
+	h, pos, err := frameheader.Read(s, s.pos, s.headerOptions, nil)
+	if err != nil {
+		if _, ok := err.(*consts.UnexpectedEOF); ok {
+			return StreamInfo{}, firstFrame{}, io.EOF
+		}
+		return StreamInfo{}, firstFrame{}, err
+	}
+	freq, err := h.SamplingFrequencyValue()
+	if err != nil {
+		return StreamInfo{}, firstFrame{}, err
+	}
+	size, err := h.FrameSize()
+	if err != nil {
+		return StreamInfo{}, firstFrame{}, err
+	}
+	if size < 4 {
+		size = 4
+	}
+	buf := make([]byte, size)
+	binary.BigEndian.PutUint32(buf, uint32(h))
+	n, err := s.ReadFull(buf[4:])
+	if err != nil && err != io.EOF {
+		return StreamInfo{}, firstFrame{}, err
+	}
+	buf = buf[:4+n]
+
+	info := StreamInfo{
+		SampleRate: freq,
+		Channels:   h.NumberOfChannels(),
+		Frames:     -1,
+		Bytes:      -1,
+		Quality:    -1,
+	}
+	x, ok := xing.Parse(buf)
+	if !ok {
+		x, ok = xing.ParseVBRI(buf)
+	}
+	if !ok {
+		// The frame is an audio frame. Unread it so that it is decoded as usual.
+		s.Unread(buf)
+	} else {
+		info.VBRHeader = x.ID
+		if x.Flags&xing.FlagFrames != 0 {
+			info.Frames = int64(x.Frames)
+		}
+		if x.Flags&xing.FlagBytes != 0 {
+			info.Bytes = int64(x.Bytes)
+		}
+		if x.Flags&xing.FlagTOC != 0 {
+			info.TOC = append([]byte{}, x.TOC[:]...)
+		}
+		if x.Flags&xing.FlagQuality != 0 {
+			info.Quality = int(x.Quality)
+		}
+		if l := x.LAME; l != nil {
+			info.Encoder = l.Encoder
+			info.LAME = &LAMETag{
+				Encoder:          l.Encoder,
+				VBRMethod:        l.VBRMethod,
+				Lowpass:          l.Lowpass,
+				Bitrate:          l.Bitrate,
+				Preset:           l.Preset,
+				VBRQuality:       -1,
+				AlgorithmQuality: -1,
+				Peak:             l.Peak,
+				TrackGain:        l.TrackGain,
+				HasTrackGain:     l.HasTrackGain,
+				AlbumGain:        l.AlbumGain,
+				HasAlbumGain:     l.HasAlbumGain,
+				EncoderDelay:     l.Delay,
+				EncoderPadding:   l.Padding,
+			}
+			// LAME writes the quality as 100 - 10*V - q.
+			if q := 100 - info.Quality; strings.HasPrefix(l.Encoder, "LAME") && info.Quality >= 0 && q < 100 {
+				info.LAME.VBRQuality = q / 10
+				info.LAME.AlgorithmQuality = q % 10
+			}
+		}
+	}
+	return info, firstFrame{
+		start:         pos,
+		bytesPerFrame: int64(h.BytesPerFrame()),
+		metadata:      ok,
+	}, nil
+}
+
+// lengthFromStreamInfo returns the total size in bytes calculated from the Xing or Info header,
+// or invalidLength if the header doesn't have the number of frames.
+func (d *Decoder) lengthFromStreamInfo() int64 {
+	if d.info.Frames < 0 {
+		return invalidLength
+	}
+	return d.info.Frames * d.first.bytesPerFrame
+}
+
+// canSeekByTOC reports whether the stream can be seeked by the TOC of the Xing or Info header.
+func (d *Decoder) canSeekByTOC() bool {
+	if d.info.TOC == nil || d.info.Bytes <= 0 {
+		return false
+	}
+	if d.lengthFromStreamInfo() <= 0 {
+		return false
+	}
+	_, ok := d.source.reader.(io.Seeker)
+	return ok
+}
+
+// seekByTOC positions the source at the frame around the PCM byte position npos by the TOC.
+// seekByTOC returns the estimated frame-aligned position.
+func (d *Decoder) seekByTOC(npos int64) (int64, error) {
+	length := d.lengthFromStreamInfo()
+	if npos < 0 {
+		npos = 0
+	}
+	if npos > length {
+		npos = length
+	}
+	npos -= npos % d.first.bytesPerFrame
+
+	percent := float64(npos) / float64(length) * 100
+	if percent > 99.999 {
+		percent = 99.999
+	}
+	i := int(percent)
+	a := float64(d.info.TOC[i])
+	b := 256.0
+	if i < len(d.info.TOC)-1 {
+		b = float64(d.info.TOC[i+1])
+	}
+	x := a + (b-a)*(percent-float64(i))
+	offset := d.first.start + int64(x/256*float64(d.info.Bytes))
+
+	if _, err := d.source.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	if err := d.source.syncFrame(); err != nil {
+		return 0, err
+	}
+	d.pos = npos
+	d.buf.reset()
+	d.resetFrame()
+	return npos, nil
+}
+
+// syncFrame advances s to the next position where two consecutive frame headers are found.
+func (s *source) syncFrame() error {
+	const window = 8192
+	buf := make([]byte, window)
+	n, err := s.ReadFull(buf)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	buf = buf[:n]
+	start := s.pos - int64(n)
+
+	// The bits of the sync word, the version, the layer and the sampling frequency must match.
+	const mask = 0xfffe0c00
+	for i := 0; i+4 <= len(buf); i++ {
+		h := frameheader.FrameHeader(binary.BigEndian.Uint32(buf[i:]))
+		if !h.IsValidWithOptions(s.headerOptions) || h.BitrateIndex() == 0 {
+			continue
+		}
+		size, err := h.FrameSize()
+		if err != nil || size < 4 {
+			continue
+		}
+		if i+size+4 <= len(buf) {
+			next := frameheader.FrameHeader(binary.BigEndian.Uint32(buf[i+size:]))
+			if !next.IsValidWithOptions(s.headerOptions) || uint32(next)&mask != uint32(h)&mask {
+				continue
+			}
+		}
+		_, err = s.Seek(start+int64(i), io.SeekStart)
+		return err
+	}
+	// No frame is found. Leave the position at the end of the window.
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+const (
+	// mpeg2AudioStart is the position of the first frame in example/mpeg2.mp3.
+	mpeg2AudioStart = 45
+
+	// mpeg2Frames is the number of frames in example/mpeg2.mp3.
+	mpeg2Frames = 2872
+)
+
+// xingFrame returns an MPEG-2 Layer III mono frame (64 kbps, 22050 Hz) with a Xing or Info header.
+func xingFrame(id string, frames, size uint32, toc []byte, quality uint32) []byte {
+	buf := make([]byte, 72*64000/22050)
+	binary.BigEndian.PutUint32(buf, 0xfff380c4)
+	// The side information is 9 bytes.
+	i := 4 + 9
+	copy(buf[i:], id)
+	binary.BigEndian.PutUint32(buf[i+4:], 0xf)
+	binary.BigEndian.PutUint32(buf[i+8:], frames)
+	binary.BigEndian.PutUint32(buf[i+12:], size)
+	copy(buf[i+16:i+116], toc)
+	binary.BigEndian.PutUint32(buf[i+116:], quality)
+	return buf
+}
+
+// mpeg2WithXing returns example/mpeg2.mp3 whose ID3v2 tag is replaced with a Xing frame.
+func mpeg2WithXing(t *testing.T) []byte {
+	t.Helper()
+	audio := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+	// The stream is CBR, so the TOC is linear.
+	toc := make([]byte, 100)
+	for i := range toc {
+		toc[i] = byte(i * 256 / 100)
+	}
+	x := xingFrame("Xing", mpeg2Frames, 0, toc, 50)
+	binary.BigEndian.PutUint32(x[4+9+12:], uint32(len(x)+len(audio)))
+	return append(x, audio...)
+}
+
+func TestStreamInfo(t *testing.T) {
+	d, err := mp3.NewDecoder(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.StreamInfo(), (mp3.StreamInfo{
+		SampleRate: 22050,
+		Channels:   1,
+		Frames:     -1,
+		Bytes:      -1,
+		Quality:    -1,
+	}); !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamInfo: got: %+v, want: %+v", got, want)
+	}
+
+	buf := mpeg2WithXing(t)
+	// Hide Seek so that the stream is not scanned.
+	d, err = mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(buf)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := d.StreamInfo()
+	if info.VBRHeader != "Xing" || info.Frames != mpeg2Frames || info.Bytes != int64(len(buf)) || info.Quality != 50 || len(info.TOC) != 100 {
+		t.Errorf("StreamInfo: got: %+v", info)
+	}
+	// The Xing frame itself is not decoded.
+	const length = mpeg2Frames * 576 * 4
+	if got, want := d.Length(), int64(length); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+	pcm, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(pcm), length; got != want {
+		t.Errorf("decoded length: got: %d, want: %d", got, want)
+	}
+	if !bytes.Equal(pcm, decodeAll(t, "example/mpeg2.mp3", nil)) {
+		t.Errorf("decoded PCM doesn't match the stream without the Xing frame")
+	}
+}
+
+func TestLAMETag(t *testing.T) {
+	buf := mpeg2WithXing(t)
+	// The LAME tag follows the Xing header with all the fields.
+	lame := buf[4+9+120:]
+	copy(lame, "LAME3.100")
+	lame[9] = 0x03
+	binary.BigEndian.PutUint16(lame[15:], 0x2e41) // Track, -6.5 dB
+	lame[20] = 32                                 // 32 kbps
+	// Delay 576, padding 1104
+	lame[21] = 0x24
+	lame[22] = 0x04
+	lame[23] = 0x50
+
+	d, err := mp3.NewDecoder(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.StreamInfo().Encoder, "LAME3.100"; got != want {
+		t.Errorf("Encoder: got: %q, want: %q", got, want)
+	}
+	got := d.StreamInfo().LAME
+	if got == nil {
+		t.Fatal("LAME tag is not found")
+	}
+	if want := (mp3.LAMETag{
+		Encoder:   "LAME3.100",
+		VBRMethod: 3,
+		Bitrate:   32,
+		// The quality 50 in the Xing header is -V 5 -q 0.
+		VBRQuality:       5,
+		AlgorithmQuality: 0,
+		TrackGain:        -6.5,
+		HasTrackGain:     true,
+		EncoderDelay:     576,
+		EncoderPadding:   1104,
+	}); *got != want {
+		t.Errorf("LAME: got: %+v, want: %+v", *got, want)
+	}
+}
+
+// blockingReaderAt blocks ReadAt until ready is closed.
+type blockingReaderAt struct {
+	*bytes.Reader
+	ready chan struct{}
+}
+
+func (b *blockingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	<-b.ready
+	return b.Reader.ReadAt(p, off)
+}
+
+func TestSeekByTOC(t *testing.T) {
+	const bytesPerFrame = 576 * 4
+
+	buf := mpeg2WithXing(t)
+	r := &blockingReaderAt{
+		Reader: bytes.NewReader(buf),
+		ready:  make(chan struct{}),
+	}
+	d, err := mp3.NewDecoderWithOptions(r, &mp3.DecoderOptions{
+		SeekMode:       mp3.SeekModeFast,
+		BackgroundScan: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The background scan is blocked, so the TOC is used.
+	const offset = 1000*bytesPerFrame + 123*4
+	pos, err := d.Seek(offset, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pos, int64(1000*bytesPerFrame); got != want {
+		t.Errorf("Seek: got: %d, want: %d", got, want)
+	}
+	if _, err := io.ReadFull(d, make([]byte, 4*bytesPerFrame)); err != nil {
+		t.Fatal(err)
+	}
+
+	close(r.ready)
+	if _, err := d.Seek(0, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Length(), int64(mpeg2Frames*bytesPerFrame); got != want {
+		t.Errorf("Length: got: %d, want: %d", got, want)
+	}
+}
+
+func mustReadFile(t testing.TB, path string) []byte {
+	t.Helper()
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
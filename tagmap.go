@@ -0,0 +1,76 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+)
+
+// A TagMap represents the byte extents of the tags and of the audio data in a source.
+type TagMap struct {
+	// Tags are the tags at the beginning and at the end of the source in the order of their offsets.
+	Tags []RawTag
+
+	// Audio is the range between the tags at the beginning and the tags at the end.
+	// Copying only Audio produces a stream without the tags.
+	Audio ByteRange
+}
+
+// ReadTagMap reads the tags at the beginning and at the end of the source r of size bytes, and returns their extents
+// and the extent of the audio data between them, e.g. for a tool stripping or replacing the tags without touching the
+// audio frames.
+//
+// The ID3v2 tags at the beginning, and the ID3v1 tag, the APE tags, the Lyrics3 v2.00 tags and the ID3v2.4 tags with
+// footers at the end are detected. Tags in the middle of the audio data are not detected. The MPEG audio frames are not validated.
+func ReadTagMap(r io.ReaderAt, size int64) (*TagMap, error) {
+	m := &TagMap{}
+	t := tagReader{r: r}
+	start := int64(0)
+
+	// The ID3v2 tags at the beginning
+	for size-start >= id3v2HeaderSize {
+		h, err := t.readAt(start, id3v2HeaderSize)
+		if err != nil {
+			return nil, err
+		}
+		n, ok := id3v2TagSize(h)
+		if !ok || start+n > size {
+			break
+		}
+		b, err := t.tag(TagFormatID3v2, start, n)
+		if err != nil {
+			return nil, err
+		}
+		m.Tags = append(m.Tags, b.rawTag())
+		start += n
+	}
+
+	tags, end, err := t.endTags(start, size)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(tags) - 1; i >= 0; i-- {
+		m.Tags = append(m.Tags, tags[i].rawTag())
+	}
+
+	m.Audio = ByteRange{
+		Offset: start,
+		Length: end - start,
+	}
+	return m, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// apeTag returns an APEv2 tag with a header and the text items of the keys and the values.
+func apeTag(items ...string) []byte {
+	var body []byte
+	for i := 0; i < len(items); i += 2 {
+		var h [8]byte
+		binary.LittleEndian.PutUint32(h[:], uint32(len(items[i+1])))
+		body = append(body, h[:]...)
+		body = append(body, items[i]...)
+		body = append(body, 0)
+		body = append(body, items[i+1]...)
+	}
+	headerOrFooter := func(isHeader bool) []byte {
+		b := make([]byte, 32)
+		copy(b, "APETAGEX")
+		binary.LittleEndian.PutUint32(b[8:], 2000)
+		binary.LittleEndian.PutUint32(b[12:], uint32(len(body)+32))
+		binary.LittleEndian.PutUint32(b[16:], uint32(len(items)/2))
+		flags := uint32(1 << 31)
+		if isHeader {
+			flags |= 1 << 29
+		}
+		binary.LittleEndian.PutUint32(b[20:], flags)
+		return b
+	}
+	b := headerOrFooter(true)
+	b = append(b, body...)
+	return append(b, headerOrFooter(false)...)
+}
+
+func TestReadTagMap(t *testing.T) {
+	v2 := id3v24Tag(id3v24Frame("TIT2", textFrame("Title")))
+	audio := mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]
+	ape := apeTag("Title", "Title", "Artist", "Artist")
+	v1 := id3v11Tag("Title", "", "", "", "", 1, 255)
+
+	var src []byte
+	for _, b := range [][]byte{v2, audio, ape, v1} {
+		src = append(src, b...)
+	}
+	m, err := mp3.ReadTagMap(bytes.NewReader(src), int64(len(src)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		format mp3.TagFormat
+		data   []byte
+	}{
+		{mp3.TagFormatID3v2, v2},
+		{mp3.TagFormatAPE, ape},
+		{mp3.TagFormatID3v1, v1},
+	}
+	if len(m.Tags) != len(want) {
+		t.Fatalf("got: %d tags, want: %d tags", len(m.Tags), len(want))
+	}
+	for i, w := range want {
+		tag := m.Tags[i]
+		if tag.Format != w.format || !bytes.Equal(tag.Data, w.data) {
+			t.Errorf("Tags[%d]: got: %v %v, want: %v", i, tag.Format, tag.Range, w.format)
+		}
+		if r := tag.Range; !bytes.Equal(src[r.Offset:r.Offset+r.Length], w.data) {
+			t.Errorf("Tags[%d]: the range %v doesn't match the tag", i, r)
+		}
+	}
+	if r := m.Audio; !bytes.Equal(src[r.Offset:r.Offset+r.Length], audio) {
+		t.Errorf("Audio: the range %v doesn't match the audio data", r)
+	}
+}
+
+func TestTrailingTags(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+
+	// An APE tag without a header is detected only by its footer.
+	ape := apeTag("Title", "\xff\xf3\x80\xc4")[32:]
+	ape[len(ape)-32+23] &^= 1 << 7
+	// The lyrics look like a frame header of MPEG-2 Layer III.
+	lyrics := []byte("LYRICSBEGININD0000211LYR00004\xff\xf3\x80\xc4")
+	lyrics = append(lyrics, fmt.Sprintf("%06dLYRICS200", len(lyrics))...)
+	v1 := id3v11Tag("Title", "", "", "", "", 1, 255)
+
+	buf := mustReadFile(t, "example/mpeg2.mp3")
+	for _, b := range [][]byte{ape, lyrics, v1} {
+		buf = append(buf, b...)
+	}
+
+	for _, backgroundScan := range []bool{false, true} {
+		d, err := mp3.NewDecoderWithOptions(bytes.NewReader(buf), &mp3.DecoderOptions{
+			BackgroundScan: backgroundScan,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("BackgroundScan: %v: decoded PCM doesn't match", backgroundScan)
+		}
+		if got, want := d.Length(), int64(len(want)); got != want {
+			t.Errorf("BackgroundScan: %v: Length: got: %d, want: %d", backgroundScan, got, want)
+		}
+		var formats []mp3.TagFormat
+		for _, tag := range d.RawTags() {
+			formats = append(formats, tag.Format)
+		}
+		if got, want := formats, []mp3.TagFormat{mp3.TagFormatID3v2, mp3.TagFormatAPE, mp3.TagFormatLyrics3, mp3.TagFormatID3v1}; !reflect.DeepEqual(got, want) {
+			t.Errorf("BackgroundScan: %v: RawTags: got: %v, want: %v", backgroundScan, got, want)
+		}
+	}
+}
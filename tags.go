@@ -0,0 +1,235 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3/internal/ape"
+	"github.com/hajimehoshi/go-mp3/internal/id3"
+)
+
+// Tags represents the metadata of the stream like the title and the artist.
+// An empty string or 0 means that the field is not available.
+type Tags struct {
+	Title       string
+	Artist      string
+	Album       string
+	AlbumArtist string
+	Composer    string
+	Comment     string
+
+	// Genre is the human-readable genre. The ID3v1 genre indices and the references to them like "(17)" in ID3v2 are
+	// replaced with their names like "Rock".
+	Genre string
+
+	// GenreRaw is the genre as it is stored in the tag, e.g. "(17)" or "17" for the ID3v1 genre index 17.
+	GenreRaw string
+
+	// Year is the year of the recording or the release.
+	Year int
+
+	// Track and TrackTotal are the track number and the total number of the tracks.
+	Track      int
+	TrackTotal int
+
+	// Disc and DiscTotal are the disc number and the total number of the discs.
+	Disc      int
+	DiscTotal int
+}
+
+// Tags returns the metadata in the ID3v2 tag at the beginning of the stream, and the APEv2 tag and the ID3v1 tag at
+// the end of the stream. The ID3v2.2, ID3v2.3, ID3v2.4, APEv2 with a header, ID3v1 and ID3v1.1 tags are supported.
+//
+// The fields in the ID3v2 tag take precedence, and the fields in the APE tag come next. The fields in the ID3v1 tag
+// are used only when they are missing in the other tags, as the ID3v1 fields are often truncated to 30 bytes.
+// The tags at the end are found when the length of the stream is scanned, i.e. when the source is io.Seeker.
+// Otherwise, they are available after the stream is read to the end.
+//
+// Tags returns nil if there is no tag.
+func (d *Decoder) Tags() *Tags {
+	var t *Tags
+	if d.source.id3v2 != nil {
+		if tag, err := id3.Parse(d.source.id3v2); err == nil {
+			t = id3v2Tags(tag)
+		}
+	}
+	if b := d.trailingTag(TagFormatAPE); b != nil && b.data != nil {
+		if items, err := ape.Parse(b.data); err == nil {
+			if t == nil {
+				t = &Tags{}
+			}
+			t.mergeAPE(items)
+		}
+	}
+	if b := d.trailingTag(TagFormatID3v1); b != nil {
+		if v1, err := id3.ParseV1(b.data); err == nil {
+			if t == nil {
+				t = &Tags{}
+			}
+			t.mergeID3v1(v1)
+		}
+	}
+	return t
+}
+
+func id3v2Tags(tag *id3.Tag) *Tags {
+	t := &Tags{}
+	for _, f := range tag.Frames {
+		id := id3.CanonicalID(f.ID, tag.MajorVersion)
+		if id == "COMM" {
+			if t.Comment != "" {
+				continue
+			}
+			c, err := id3.ParseComment(f.Data)
+			if err != nil {
+				continue
+			}
+			t.Comment = c.Text
+			continue
+		}
+		if !strings.HasPrefix(id, "T") {
+			continue
+		}
+		v := id3.Text(f.Data)
+		// The first frame wins when there are multiple frames with the same ID.
+		switch id {
+		case "TIT2":
+			setString(&t.Title, v)
+		case "TPE1":
+			setString(&t.Artist, v)
+		case "TALB":
+			setString(&t.Album, v)
+		case "TPE2":
+			setString(&t.AlbumArtist, v)
+		case "TCOM":
+			setString(&t.Composer, v)
+		case "TCON":
+			t.setGenre(v, id3.GenreNames(id3.TextValues(f.Data)))
+		case "TYER", "TDRC":
+			// TDRC in ID3v2.4 is a timestamp like "2006-01-02".
+			if t.Year == 0 {
+				t.Year = leadingInt(v)
+			}
+		case "TRCK":
+			if t.Track == 0 {
+				t.Track, t.TrackTotal = parsePosition(v)
+			}
+		case "TPOS":
+			if t.Disc == 0 {
+				t.Disc, t.DiscTotal = parsePosition(v)
+			}
+		}
+	}
+	return t
+}
+
+// mergeAPE sets the text items of an APE tag to the fields of t that are not set yet.
+func (t *Tags) mergeAPE(items []ape.Item) {
+	for _, item := range items {
+		if item.Type != ape.ItemTypeText {
+			continue
+		}
+		v := item.Text()
+		switch strings.ToLower(item.Key) {
+		case "title":
+			setString(&t.Title, v)
+		case "artist":
+			setString(&t.Artist, v)
+		case "album":
+			setString(&t.Album, v)
+		case "album artist", "albumartist":
+			setString(&t.AlbumArtist, v)
+		case "composer":
+			setString(&t.Composer, v)
+		case "genre":
+			t.setGenre(v, id3.GenreNames([]string{v}))
+		case "comment":
+			setString(&t.Comment, v)
+		case "year":
+			if t.Year == 0 {
+				t.Year = leadingInt(v)
+			}
+		case "track":
+			if t.Track == 0 {
+				t.Track, t.TrackTotal = parsePosition(v)
+			}
+		case "disc":
+			if t.Disc == 0 {
+				t.Disc, t.DiscTotal = parsePosition(v)
+			}
+		}
+	}
+}
+
+// mergeID3v1 sets the fields of v1 to the fields of t that are not set yet.
+func (t *Tags) mergeID3v1(v1 *id3.V1) {
+	setString(&t.Title, v1.Title)
+	setString(&t.Artist, v1.Artist)
+	setString(&t.Album, v1.Album)
+	setString(&t.Comment, v1.Comment)
+	if name := id3.GenreName(v1.Genre); name != "" {
+		t.setGenre(strconv.Itoa(v1.Genre), []string{name})
+	}
+	if t.Year == 0 {
+		t.Year = v1.Year
+	}
+	if t.Track == 0 {
+		t.Track = v1.Track
+	}
+}
+
+// setGenre sets the raw genre and the names to the genre fields of t unless they are already set.
+func (t *Tags) setGenre(raw string, names []string) {
+	if t.GenreRaw != "" {
+		return
+	}
+	t.GenreRaw = raw
+	t.Genre = strings.Join(names, "/")
+}
+
+// setString sets v to s unless s is already set.
+func setString(s *string, v string) {
+	if *s == "" {
+		*s = v
+	}
+}
+
+// parsePosition parses a position like "3" or "3/12" and returns the number and the total.
+func parsePosition(s string) (int, int) {
+	n, total := s, ""
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		n, total = s[:i], s[i+1:]
+	}
+	return leadingInt(n), leadingInt(total)
+}
+
+// leadingInt returns the integer at the beginning of s, or 0 if s doesn't start with a digit.
+func leadingInt(s string) int {
+	s = strings.TrimSpace(s)
+	i := 0
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	n, err := strconv.Atoi(s[:i])
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,473 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// textFrame returns the content of a text frame in UTF-8.
+func textFrame(s string) []byte {
+	return append([]byte{3}, s...)
+}
+
+// id3v22Tag returns an ID3v2.2 tag with the frames of the IDs and the contents.
+func id3v22Tag(frames ...[2]string) []byte {
+	var body []byte
+	for _, f := range frames {
+		n := len(f[1])
+		body = append(body, f[0]...)
+		body = append(body, byte(n>>16), byte(n>>8), byte(n))
+		body = append(body, f[1]...)
+	}
+	b := append([]byte("ID3\x02\x00\x00"), syncsafe(len(body))...)
+	return append(b, body...)
+}
+
+// withAudio returns the tag followed by the audio frames of mpeg2.mp3.
+func withAudio(t *testing.T, tag []byte) []byte {
+	return append(append([]byte{}, tag...), mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:]...)
+}
+
+func TestTags(t *testing.T) {
+	want := mp3.Tags{
+		Title:       "Title",
+		Artist:      "Artist",
+		Album:       "Album",
+		AlbumArtist: "Album Artist",
+		Genre:       "Jazz",
+		GenreRaw:    "Jazz",
+		Comment:     "Comment",
+		Year:        2006,
+		Track:       3,
+		TrackTotal:  12,
+		Disc:        1,
+		DiscTotal:   2,
+	}
+
+	v24 := id3v24Tag(
+		id3v24Frame("TIT2", textFrame("Title")),
+		id3v24Frame("TPE1", textFrame("Artist")),
+		// The first frame wins.
+		id3v24Frame("TPE1", textFrame("Another Artist")),
+		id3v24Frame("TALB", textFrame("Album")),
+		id3v24Frame("TPE2", textFrame("Album Artist")),
+		id3v24Frame("TCON", textFrame("Jazz")),
+		id3v24Frame("COMM", append(textFrame("eng"), "\x00Comment"...)),
+		id3v24Frame("TDRC", textFrame("2006-01-02")),
+		id3v24Frame("TRCK", textFrame("3/12")),
+		id3v24Frame("TPOS", textFrame("1/2")),
+	)
+	v22 := id3v22Tag(
+		[2]string{"TT2", "\x00Title"},
+		[2]string{"TP1", "\x00Artist"},
+		[2]string{"TAL", "\x00Album"},
+		[2]string{"TP2", "\x00Album Artist"},
+		[2]string{"TCO", "\x00Jazz"},
+		[2]string{"COM", "\x00eng\x00Comment"},
+		[2]string{"TYE", "\x002006"},
+		[2]string{"TRK", "\x003/12"},
+		[2]string{"TPA", "\x001/2"},
+	)
+
+	for name, tag := range map[string][]byte{"ID3v2.4": v24, "ID3v2.2": v22} {
+		d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, tag)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := d.Tags()
+		if got == nil {
+			t.Fatalf("%s: Tags must not be nil", name)
+		}
+		if *got != want {
+			t.Errorf("%s: got: %+v, want: %+v", name, *got, want)
+		}
+	}
+
+	d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Tags(); got != nil {
+		t.Errorf("got: %+v, want: nil", got)
+	}
+}
+
+// id3v11Tag returns an ID3v1.1 tag.
+func id3v11Tag(title, artist, album, year, comment string, track, genre byte) []byte {
+	b := make([]byte, 128)
+	copy(b, "TAG")
+	copy(b[3:33], title)
+	copy(b[33:63], artist)
+	copy(b[63:93], album)
+	copy(b[93:97], year)
+	copy(b[97:125], comment)
+	b[126] = track
+	b[127] = genre
+	return b
+}
+
+func TestID3v1Tags(t *testing.T) {
+	v1 := id3v11Tag("Title v1", "Artist v1", "Album v1", "1999", "Comment v1", 7, 8)
+
+	t.Run("ID3v1 only", func(t *testing.T) {
+		d, err := mp3.NewDecoder(bytes.NewReader(append(withAudio(t, nil), v1...)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := d.Tags()
+		if got == nil {
+			t.Fatal("Tags must not be nil")
+		}
+		want := mp3.Tags{
+			Title:    "Title v1",
+			Artist:   "Artist v1",
+			Album:    "Album v1",
+			Genre:    "Jazz",
+			GenreRaw: "8",
+			Comment:  "Comment v1",
+			Year:     1999,
+			Track:    7,
+		}
+		if *got != want {
+			t.Errorf("got: %+v, want: %+v", *got, want)
+		}
+	})
+
+	t.Run("precedence", func(t *testing.T) {
+		v2 := id3v24Tag(
+			id3v24Frame("TIT2", textFrame("Title")),
+			id3v24Frame("TRCK", textFrame("3/12")),
+		)
+		d, err := mp3.NewDecoder(bytes.NewReader(append(withAudio(t, v2), v1...)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := d.Tags()
+		if got == nil {
+			t.Fatal("Tags must not be nil")
+		}
+		want := mp3.Tags{
+			Title:      "Title",
+			Artist:     "Artist v1",
+			Album:      "Album v1",
+			Genre:      "Jazz",
+			GenreRaw:   "8",
+			Comment:    "Comment v1",
+			Year:       1999,
+			Track:      3,
+			TrackTotal: 12,
+		}
+		if *got != want {
+			t.Errorf("got: %+v, want: %+v", *got, want)
+		}
+	})
+
+	t.Run("non-seekable", func(t *testing.T) {
+		r := struct{ io.Reader }{bytes.NewReader(append(withAudio(t, nil), v1...))}
+		d, err := mp3.NewDecoder(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := d.Tags(); got != nil {
+			t.Errorf("got: %+v, want: nil before the end", got)
+		}
+		if _, err := io.Copy(ioutil.Discard, d); err != nil {
+			t.Fatal(err)
+		}
+		got := d.Tags()
+		if got == nil {
+			t.Fatal("Tags must not be nil at the end")
+		}
+		if got.Title != "Title v1" {
+			t.Errorf("got: %q, want: %q", got.Title, "Title v1")
+		}
+	})
+}
+
+// unsynchronise applies the unsynchronisation scheme of ID3v2 to b.
+func unsynchronise(b []byte) []byte {
+	var r []byte
+	for i, c := range b {
+		r = append(r, c)
+		if c == 0xff && (i+1 == len(b) || b[i+1] == 0 || b[i+1]&0xe0 == 0xe0) {
+			r = append(r, 0)
+		}
+	}
+	return r
+}
+
+// id3v24FrameWithFlags returns an ID3v2.4 frame with the format flags.
+func id3v24FrameWithFlags(id string, flags byte, data []byte) []byte {
+	b := append([]byte(id), syncsafe(len(data))...)
+	b = append(b, 0, flags)
+	return append(b, data...)
+}
+
+func TestTagsFrameEncodings(t *testing.T) {
+	// "\xff\xe0" in ISO-8859-1 is "ÿà", which looks like a frame sync.
+	title := []byte("\x00Title \xff\xe0")
+	const wantTitle = "Title ÿà"
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	if _, err := w.Write([]byte("\x03Artist")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A long comment whose frame size is written as a plain integer by a broken encoder.
+	longComment := strings.Repeat("a", 200)
+	commData := append(textFrame("eng"), "\x00"+longComment...)
+	plainSizeComm := append([]byte("COMM"), 0, 0, 0, byte(len(commData)), 0, 0)
+	plainSizeComm = append(plainSizeComm, commData...)
+
+	// An ID3v2.3 tag with the unsynchronisation applied to the whole tag.
+	v23Body := append([]byte("TIT2"), 0, 0, 0, byte(len(title)), 0, 0)
+	v23Body = append(v23Body, title...)
+	v23Body = unsynchronise(v23Body)
+	v23 := append([]byte("ID3\x03\x00\x80"), syncsafe(len(v23Body))...)
+	v23 = append(v23, v23Body...)
+
+	// An ID3v2.4 tag with an unsynchronised frame with a data length indicator, and a compressed frame.
+	v24 := id3v24Tag(
+		id3v24FrameWithFlags("TIT2", 0x03, append(syncsafe(len(title)), unsynchronise(title)...)),
+		id3v24FrameWithFlags("TPE1", 0x09, append(syncsafe(len("\x03Artist")), compressed.Bytes()...)),
+		plainSizeComm,
+		id3v24Frame("TALB", textFrame("Album")),
+	)
+
+	// Multiple tags precede the audio data. The tags are skipped without resyncing.
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(withAudio(t, append(v24, v23...))), &mp3.DecoderOptions{
+		OnResync: func(skippedBytes int64) {
+			t.Errorf("OnResync must not be called: %d bytes", skippedBytes)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := d.Tags()
+	if got == nil {
+		t.Fatal("Tags must not be nil")
+	}
+	want := mp3.Tags{
+		Title:   wantTitle,
+		Artist:  "Artist",
+		Album:   "Album",
+		Comment: longComment,
+	}
+	if *got != want {
+		t.Errorf("ID3v2.4: got: %+v, want: %+v", *got, want)
+	}
+
+	d, err = mp3.NewDecoder(bytes.NewReader(withAudio(t, v23)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Tags(); got == nil || got.Title != wantTitle {
+		t.Errorf("ID3v2.3: got: %+v, want: %q", got, wantTitle)
+	}
+}
+
+// utf16Text returns s in UTF-16 with the byte order. If bom is true, the BOM precedes the text.
+func utf16Text(s string, bigEndian, bom bool) []byte {
+	var b []byte
+	put := func(u uint16) {
+		if bigEndian {
+			b = append(b, byte(u>>8), byte(u))
+		} else {
+			b = append(b, byte(u), byte(u>>8))
+		}
+	}
+	if bom {
+		put(0xfeff)
+	}
+	for _, u := range utf16.Encode([]rune(s)) {
+		put(u)
+	}
+	return b
+}
+
+func TestTagsTextEncodings(t *testing.T) {
+	comment := append([]byte{1}, "jpn"...)
+	comment = append(comment, utf16Text("説明", false, true)...)
+	comment = append(comment, 0, 0)
+	comment = append(comment, utf16Text("コメント", true, true)...)
+
+	tag := id3v24Tag(
+		id3v24Frame("TIT2", append([]byte{1}, utf16Text("日本語のタイトル", false, true)...)),
+		id3v24Frame("TPE1", append([]byte{1}, utf16Text("Artist without BOM", false, false)...)),
+		id3v24Frame("TALB", append([]byte{2}, utf16Text("Ålbum", true, false)...)),
+		id3v24Frame("TCOM", append([]byte{0}, "Caf\xe9"...)),
+		id3v24Frame("TCON", textFrame("Rock\x00Pop\x00")),
+		id3v24Frame("COMM", comment),
+	)
+	d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, tag)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := d.Tags()
+	if got == nil {
+		t.Fatal("Tags must not be nil")
+	}
+	want := mp3.Tags{
+		Title:    "日本語のタイトル",
+		Artist:   "Artist without BOM",
+		Album:    "Ålbum",
+		Composer: "Café",
+		Genre:    "Rock/Pop",
+		GenreRaw: "Rock/Pop",
+		Comment:  "コメント",
+	}
+	if *got != want {
+		t.Errorf("got: %+v, want: %+v", *got, want)
+	}
+}
+
+func TestGenre(t *testing.T) {
+	for _, tc := range []struct {
+		tcon string
+		want string
+	}{
+		{"Jazz", "Jazz"},
+		{"(8)", "Jazz"},
+		{"(17)(18)", "Rock/Techno"},
+		{"(17)Eurorock", "Rock/Eurorock"},
+		{"(17)Rock", "Rock"},
+		{"((Live)", "(Live)"},
+		{"(RX)(CR)", "Remix/Cover"},
+		{"(999)", "(999)"},
+		// ID3v2.4 separates the values with the terminators.
+		{"17\x00Eurorock\x00RX", "Rock/Eurorock/Remix"},
+		{"1999", "1999"},
+	} {
+		tag := id3v24Tag(id3v24Frame("TCON", textFrame(tc.tcon)))
+		d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, tag)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := d.Tags()
+		if got == nil {
+			t.Fatal("Tags must not be nil")
+		}
+		if got.Genre != tc.want {
+			t.Errorf("TCON %q: Genre: got: %q, want: %q", tc.tcon, got.Genre, tc.want)
+		}
+		if want := strings.Replace(tc.tcon, "\x00", "/", -1); got.GenreRaw != want {
+			t.Errorf("TCON %q: GenreRaw: got: %q, want: %q", tc.tcon, got.GenreRaw, want)
+		}
+	}
+}
+
+func TestAPETags(t *testing.T) {
+	ape := apeTag(
+		"Title", "Title APE",
+		"ARTIST", "Artist APE",
+		"Album Artist", "Album Artist APE",
+		"Track", "5/9",
+		"Year", "2010",
+		"REPLAYGAIN_TRACK_GAIN", "-3.50 dB",
+	)
+	v1 := id3v11Tag("Title v1", "Artist v1", "Album v1", "1999", "Comment v1", 7, 255)
+	v2 := id3v24Tag(id3v24Frame("TIT2", textFrame("Title")))
+
+	var src []byte
+	for _, b := range [][]byte{v2, mustReadFile(t, "example/mpeg2.mp3")[mpeg2AudioStart:], ape, v1} {
+		src = append(src, b...)
+	}
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := d.Tags()
+	if got == nil {
+		t.Fatal("Tags must not be nil")
+	}
+	want := mp3.Tags{
+		Title:       "Title",
+		Artist:      "Artist APE",
+		Album:       "Album v1",
+		AlbumArtist: "Album Artist APE",
+		Comment:     "Comment v1",
+		Year:        2010,
+		Track:       5,
+		TrackTotal:  9,
+	}
+	if *got != want {
+		t.Errorf("got: %+v, want: %+v", *got, want)
+	}
+	if r := d.ReplayGain(); !r.HasTrackGain || r.TrackGain != -3.5 {
+		t.Errorf("ReplayGain: got: %+v, want: the track gain -3.5", r)
+	}
+
+	// The APE tag must not be decoded as audio.
+	decoded, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := decodeAll(t, "example/mpeg2.mp3", nil); !bytes.Equal(decoded, want) {
+		t.Errorf("the decoded PCM doesn't match")
+	}
+}
+
+func TestID3Frames(t *testing.T) {
+	tag := id3v24Tag(
+		id3v24Frame("TIT2", textFrame("Title")),
+		txxxFrame("MusicBrainz Album Id", "0123"),
+		id3v24Frame("UFID", []byte("http://musicbrainz.org\x00abcd")),
+		id3v24Frame("PRIV", []byte("com.example\x00\x01\x02")),
+	)
+	d, err := mp3.NewDecoder(bytes.NewReader(withAudio(t, tag)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames := d.ID3Frames()
+	var ids []string
+	for _, f := range frames {
+		ids = append(ids, f.ID)
+	}
+	if got, want := strings.Join(ids, ","), "TIT2,TXXX,UFID,PRIV"; got != want {
+		t.Fatalf("IDs: got: %s, want: %s", got, want)
+	}
+
+	if got := frames[0].Text(); got != "Title" {
+		t.Errorf("Text: got: %q, want: %q", got, "Title")
+	}
+	if desc, value, ok := frames[1].UserText(); !ok || desc != "MusicBrainz Album Id" || value != "0123" {
+		t.Errorf("UserText: got: %q, %q, %v", desc, value, ok)
+	}
+	if owner, data, ok := frames[2].Owner(); !ok || owner != "http://musicbrainz.org" || string(data) != "abcd" {
+		t.Errorf("Owner: got: %q, %q, %v", owner, data, ok)
+	}
+	if owner, data, ok := frames[3].Owner(); !ok || owner != "com.example" || !bytes.Equal(data, []byte{1, 2}) {
+		t.Errorf("Owner: got: %q, %v, %v", owner, data, ok)
+	}
+	if _, _, ok := frames[0].Owner(); ok {
+		t.Errorf("Owner must fail for TIT2")
+	}
+}
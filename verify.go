@@ -0,0 +1,123 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+
+	"github.com/hajimehoshi/go-mp3/internal/consts"
+	"github.com/hajimehoshi/go-mp3/internal/frame"
+)
+
+// VerifyReport represents the result of Verify.
+type VerifyReport struct {
+	// Frames is the number of the valid frames including a Xing, Info or VBRI frame.
+	Frames int64
+
+	// BadFrames is the frames that can't be decoded.
+	BadFrames []BadFrame
+
+	// CRCErrors is the number of the frames whose CRC doesn't match.
+	CRCErrors int64
+
+	// ReservoirUnderflows is the number of the frames whose main data refers back to more bytes than the bit
+	// reservoir has.
+	ReservoirUnderflows int64
+
+	// SkippedBytes is the number of the bytes skipped to find frame headers, including tags embedded in the stream.
+	SkippedBytes int64
+
+	// Truncated reports whether the stream ends in the middle of a frame.
+	Truncated bool
+}
+
+// OK reports whether no problem is found.
+func (r *VerifyReport) OK() bool {
+	return len(r.BadFrames) == 0 && r.CRCErrors == 0 && r.ReservoirUnderflows == 0 && r.SkippedBytes == 0 && !r.Truncated
+}
+
+// BadFrame represents a frame that can't be decoded.
+type BadFrame struct {
+	// Offset is the byte offset of the frame in the source.
+	Offset int64
+
+	// Err is the reason why the frame can't be decoded.
+	Err error
+}
+
+// Verify checks the integrity of the stream r without decoding PCM.
+//
+// Verify parses the frame headers, the side information and the Huffman codes of all the frames, and checks their
+// CRCs. Verify is much faster than decoding as it doesn't run the requantization, the IMDCT nor the synthesis.
+//
+// Verify returns an error only when the stream can't be checked at all, e.g. r is not MPEG audio.
+// Problems in the stream are reported in the returned report.
+func Verify(r io.Reader) (*VerifyReport, error) {
+	s := &source{
+		reader: r,
+	}
+	if err := s.skipTags(); err != nil {
+		return nil, err
+	}
+	if err := s.detectFormat(); err != nil {
+		return nil, err
+	}
+
+	report := &VerifyReport{}
+	var prev *frame.Frame
+	for {
+		if ok, err := s.atEndTags(); err != nil {
+			return nil, err
+		} else if ok {
+			break
+		}
+		start := s.pos
+		f, pos, err := frame.Read(s, s.pos, prev, frame.Options{})
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			if _, ok := err.(*consts.UnexpectedEOF); ok {
+				report.Truncated = true
+				break
+			}
+			// If nothing is consumed, e.g. due to an I/O error, the same error would happen forever.
+			if s.pos <= start {
+				return nil, err
+			}
+			if pos < start {
+				pos = start
+			}
+			report.BadFrames = append(report.BadFrames, BadFrame{
+				Offset: pos,
+				Err:    err,
+			})
+			continue
+		}
+		report.Frames++
+		report.SkippedBytes += pos - start
+		if f.CRCMismatch() {
+			report.CRCErrors++
+		}
+		if f.ReservoirUnderflow() {
+			report.ReservoirUnderflows++
+		}
+		prev = f
+	}
+	return report, nil
+}
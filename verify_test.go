@@ -0,0 +1,60 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestVerify(t *testing.T) {
+	r, err := mp3.Verify(bytes.NewReader(mustReadFile(t, "example/mpeg2.mp3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK() {
+		t.Errorf("got: %+v, want: no problem", r)
+	}
+	if r.Frames != mpeg2Frames {
+		t.Errorf("Frames: got: %d, want: %d", r.Frames, mpeg2Frames)
+	}
+
+	const index = 100
+	src := corruptedMPEG2(t, index)
+	r, err = mp3.Verify(bytes.NewReader(src[:len(src)-100]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.BadFrames) != 1 || !errors.Is(r.BadFrames[0].Err, mp3.ErrInvalidSideInfo) {
+		t.Errorf("BadFrames: got: %v, want: a frame with invalid side information", r.BadFrames)
+	}
+	if !r.Truncated {
+		t.Errorf("Truncated: got: false, want: true")
+	}
+
+	r, err = mp3.Verify(bytes.NewReader(protectedFrames(10, 3, 7)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Frames != 10 || r.CRCErrors != 2 {
+		t.Errorf("got: %d frames and %d CRC errors, want: 10 frames and 2 CRC errors", r.Frames, r.CRCErrors)
+	}
+}
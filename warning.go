@@ -0,0 +1,89 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3
+
+import (
+	"fmt"
+)
+
+// WarningKind represents the kind of a Warning.
+type WarningKind int
+
+const (
+	// WarningSkippedBytes indicates that bytes were skipped to find the next frame header.
+	// Tags embedded in the stream are also counted.
+	WarningSkippedBytes WarningKind = iota
+
+	// WarningReservoirUnderflow indicates that the main data of a frame refers back to more bytes than the bit
+	// reservoir has, e.g. at the beginning of a stream cut in the middle. The frame is decoded as silence.
+	WarningReservoirUnderflow
+
+	// WarningConcealedFrame indicates that a frame was skipped or concealed by the FrameError or CRCMismatch
+	// policy.
+	WarningConcealedFrame
+
+	// WarningTruncated indicates that the stream ends in the middle of a frame. The frame is dropped unless
+	// PartialFinalFrame is set.
+	// The truncation is reported when the scan to calculate the length finds it, and again when the frame is read.
+	WarningTruncated
+)
+
+// String implements fmt.Stringer.
+func (k WarningKind) String() string {
+	switch k {
+	case WarningSkippedBytes:
+		return "skipped bytes"
+	case WarningReservoirUnderflow:
+		return "reservoir underflow"
+	case WarningConcealedFrame:
+		return "concealed frame"
+	case WarningTruncated:
+		return "truncated"
+	}
+	return fmt.Sprintf("WarningKind(%d)", int(k))
+}
+
+// Warning represents a non-fatal condition while decoding.
+type Warning struct {
+	Kind WarningKind
+
+	// Offset is the byte offset in the source where the condition happens.
+	Offset int64
+
+	// Bytes is the number of the skipped bytes for WarningSkippedBytes.
+	Bytes int64
+
+	// Err is the cause for WarningConcealedFrame and WarningTruncated.
+	Err error
+}
+
+// String implements fmt.Stringer.
+func (w Warning) String() string {
+	s := fmt.Sprintf("mp3: %s at offset %d", w.Kind, w.Offset)
+	if w.Kind == WarningSkippedBytes {
+		s += fmt.Sprintf(" (%d bytes)", w.Bytes)
+	}
+	if w.Err != nil {
+		s += fmt.Sprintf(": %v", w.Err)
+	}
+	return s
+}
+
+// warn reports w to the OnWarning callback if any.
+func (d *Decoder) warn(w Warning) {
+	if d.onWarning != nil {
+		d.onWarning(w)
+	}
+}
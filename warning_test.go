@@ -0,0 +1,191 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mp3_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func TestOnWarning(t *testing.T) {
+	const junkAt = 50
+	const badAt = 100
+	const junkSize = 16
+
+	frames := splitMPEG2Frames(t)
+	var src []byte
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	offset := int64(mpeg2AudioStart)
+	var junkOffset, badOffset int64
+	for i, f := range frames {
+		if i == junkAt {
+			junkOffset = offset
+			src = append(src, make([]byte, junkSize)...)
+			offset += junkSize
+		}
+		if i == badAt {
+			badOffset = offset
+		}
+		src = append(src, f...)
+		offset += int64(len(f))
+	}
+	// Make big_values of the first granule invalid.
+	src[badOffset+4+2] |= 0x07
+	src[badOffset+4+3] |= 0xfc
+
+	var warnings []mp3.Warning
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		FrameError: mp3.FrameErrorSilence,
+		OnWarning: func(w mp3.Warning) {
+			warnings = append(warnings, w)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(warnings) != 2 {
+		t.Fatalf("got: %v, want: 2 warnings", warnings)
+	}
+	if w := warnings[0]; w.Kind != mp3.WarningSkippedBytes || w.Offset != junkOffset || w.Bytes != junkSize {
+		t.Errorf("got: %v, want: %d skipped bytes at %d", w, junkSize, junkOffset)
+	}
+	if w := warnings[1]; w.Kind != mp3.WarningConcealedFrame || w.Offset != badOffset || !errors.Is(w.Err, mp3.ErrInvalidSideInfo) {
+		t.Errorf("got: %v, want: a concealed frame at %d", w, badOffset)
+	}
+}
+
+func TestEventCallbacks(t *testing.T) {
+	const frameSize = 72 * 64000 / 22050
+	const junkSize = 16
+
+	frames := protectedFrames(10, 3, 7)
+	var src []byte
+	src = append(src, frames[:5*frameSize]...)
+	src = append(src, make([]byte, junkSize)...)
+	src = append(src, frames[5*frameSize:]...)
+
+	var crcErrors []int64
+	var resyncs []int64
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		OnCRCError: func(offset int64) {
+			crcErrors = append(crcErrors, offset)
+		},
+		OnResync: func(skippedBytes int64) {
+			resyncs = append(resyncs, skippedBytes)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(d); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []int64{3 * frameSize, 7*frameSize + junkSize}; !reflect.DeepEqual(crcErrors, want) {
+		t.Errorf("OnCRCError: got: %v, want: %v", crcErrors, want)
+	}
+	if want := []int64{junkSize}; !reflect.DeepEqual(resyncs, want) {
+		t.Errorf("OnResync: got: %v, want: %v", resyncs, want)
+	}
+}
+
+func TestScanWarnsTruncation(t *testing.T) {
+	frames := splitMPEG2Frames(t)
+	var src []byte
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for _, f := range frames[:100] {
+		src = append(src, f...)
+	}
+	lastOffset := int64(len(src))
+
+	for _, c := range []struct {
+		name string
+		size int
+	}{
+		{"frame header", 2},
+		{"frame body", len(frames[100]) / 2},
+	} {
+		src := append(src[:lastOffset:lastOffset], frames[100][:c.size]...)
+		var warnings []mp3.Warning
+		if _, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+			OnWarning: func(w mp3.Warning) {
+				warnings = append(warnings, w)
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		// The scan in NewDecoderWithOptions reports the truncation before the frame is read.
+		if len(warnings) != 1 {
+			t.Fatalf("%s: got: %v, want: 1 warning", c.name, warnings)
+		}
+		if w := warnings[0]; w.Kind != mp3.WarningTruncated || w.Offset != lastOffset || !errors.Is(w.Err, mp3.ErrTruncated) {
+			t.Errorf("%s: got: %v, want: a truncated frame at %d", c.name, w, lastOffset)
+		}
+	}
+}
+
+func TestBackgroundScanWarnsTruncation(t *testing.T) {
+	frames := splitMPEG2Frames(t)
+	var src []byte
+	src = append(src, mustReadFile(t, "example/mpeg2.mp3")[:mpeg2AudioStart]...)
+	for _, f := range frames[:100] {
+		src = append(src, f...)
+	}
+	lastOffset := int64(len(src))
+	src = append(src, frames[100][:2]...)
+
+	// OnWarning is called on the goroutine calling Read, so warnings doesn't need a lock.
+	var warnings []mp3.Warning
+	d, err := mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		BackgroundScan: true,
+		OnWarning: func(w mp3.Warning) {
+			warnings = append(warnings, w)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	// Length returns -1 until the scan finishes.
+	for d.Length() == -1 {
+		time.Sleep(time.Millisecond)
+	}
+	// The truncation found by the scan is not reported on the scan goroutine, but by Read.
+	if len(warnings) != 0 {
+		t.Fatalf("got: %v before Read, want: no warnings", warnings)
+	}
+	if _, err := ioutil.ReadAll(d); err != nil {
+		t.Fatal(err)
+	}
+	// Both the scan and Read report the truncation.
+	if len(warnings) != 2 {
+		t.Fatalf("got: %v, want: 2 warnings", warnings)
+	}
+	for _, w := range warnings {
+		if w.Kind != mp3.WarningTruncated || w.Offset != lastOffset || !errors.Is(w.Err, mp3.ErrTruncated) {
+			t.Errorf("got: %v, want: a truncated frame at %d", w, lastOffset)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"io"
+
+	"github.com/hajimehoshi/go-mp3/wav"
+)
+
+// ConvertToWAV decodes the MP3 stream r and writes the PCM to w as a WAV file of 16-bit stereo PCM.
+//
+// The PCM is streamed and the whole stream is not buffered. The header of the WAV file needs the size of the PCM:
+// when r is an io.Seeker, the size is known by scanning the stream first. Otherwise, the header has the maximum sizes
+// unless w is an io.WriteSeeker, whose header is updated at the end.
+//
+// A change of the stream parameters in the middle of the stream, e.g. the sample rate, is an error wrapping
+// ErrParameterChange as a WAV file can't have it.
+func ConvertToWAV(r io.Reader, w io.Writer) error {
+	d, err := NewDecoderWithOptions(r, &DecoderOptions{
+		ParameterChange: ParameterChangeError,
+	})
+	if err != nil {
+		return err
+	}
+	size := d.remainingLength()
+	if size == invalidLength {
+		size = wav.UnknownSize
+	}
+	// The decoded PCM is always stereo.
+	ww, err := wav.NewWriter(w, d.SampleRate(), 2, size)
+	if err != nil {
+		return err
+	}
+	if _, err := d.WriteTo(ww); err != nil {
+		return err
+	}
+	return ww.Close()
+}
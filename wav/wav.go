@@ -0,0 +1,175 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wav implements a writer of WAV files of 16-bit linear PCM.
+package wav
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// HeaderSize is the size of the header of a WAV file written by Writer in bytes.
+const HeaderSize = 44
+
+// UnknownSize is the data size for NewWriter when the size of the PCM is not known in advance.
+const UnknownSize = -1
+
+// maxDataSize is the maximum size of the PCM in a WAV file, whose sizes are 32-bit.
+const maxDataSize = 0xffffffff - (HeaderSize - 8)
+
+const (
+	bitsPerSample = 16
+	formatPCM     = 1
+)
+
+// Header returns the header of a WAV file of 16-bit linear PCM.
+//
+// dataSize is the size of the PCM in bytes. If dataSize is UnknownSize, the sizes in the header are the maximum
+// values, which most players and tools regard as "until the end of the file".
+func Header(sampleRate, channels int, dataSize int64) ([]byte, error) {
+	if sampleRate <= 0 {
+		return nil, fmt.Errorf("wav: invalid sample rate: %d", sampleRate)
+	}
+	if channels <= 0 || channels > 0xffff {
+		return nil, fmt.Errorf("wav: invalid number of channels: %d", channels)
+	}
+	if dataSize != UnknownSize && (dataSize < 0 || dataSize > maxDataSize) {
+		return nil, fmt.Errorf("wav: invalid data size: %d", dataSize)
+	}
+
+	blockAlign := channels * bitsPerSample / 8
+	riffSize := uint32(0xffffffff)
+	size := uint32(0xffffffff)
+	if dataSize != UnknownSize {
+		// The data chunk is padded to an even size.
+		riffSize = uint32(HeaderSize - 8 + dataSize + dataSize&1)
+		size = uint32(dataSize)
+	}
+
+	h := make([]byte, HeaderSize)
+	copy(h[0:], "RIFF")
+	binary.LittleEndian.PutUint32(h[4:], riffSize)
+	copy(h[8:], "WAVE")
+	copy(h[12:], "fmt ")
+	binary.LittleEndian.PutUint32(h[16:], 16)
+	binary.LittleEndian.PutUint16(h[20:], formatPCM)
+	binary.LittleEndian.PutUint16(h[22:], uint16(channels))
+	binary.LittleEndian.PutUint32(h[24:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(h[28:], uint32(sampleRate*blockAlign))
+	binary.LittleEndian.PutUint16(h[32:], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(h[34:], bitsPerSample)
+	copy(h[36:], "data")
+	binary.LittleEndian.PutUint32(h[40:], size)
+	return h, nil
+}
+
+// A Writer writes 16-bit little-endian linear PCM to a WAV file.
+type Writer struct {
+	w          io.Writer
+	sampleRate int
+	channels   int
+
+	// dataSize is the data size in the header, or UnknownSize.
+	dataSize int64
+
+	// written is the number of the bytes of the PCM written so far.
+	written int64
+
+	closed bool
+}
+
+// NewWriter writes the header of a WAV file to w and returns a Writer to write the PCM after the header.
+//
+// dataSize is the size of the PCM in bytes, or UnknownSize. When w is an io.WriteSeeker like *os.File, Close
+// updates the header with the actual size, so dataSize can be UnknownSize. Otherwise, the header is final: the PCM
+// must be exactly dataSize bytes, or the header has the maximum sizes with UnknownSize.
+func NewWriter(w io.Writer, sampleRate, channels int, dataSize int64) (*Writer, error) {
+	h, err := Header(sampleRate, channels, dataSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(h); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		w:          w,
+		sampleRate: sampleRate,
+		channels:   channels,
+		dataSize:   dataSize,
+	}, nil
+}
+
+// Write writes the PCM p.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("wav: Write after Close")
+	}
+	if w.written+int64(len(p)) > maxDataSize {
+		return 0, errors.New("wav: the PCM exceeds the maximum size of a WAV file")
+	}
+	n, err := w.w.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// Close finishes the WAV file. Close doesn't close the underlying writer.
+//
+// When the underlying writer is an io.WriteSeeker and the size of the written PCM differs from the size in the
+// header, Close updates the header. Otherwise, Close returns an error for the mismatch.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	// The data chunk is padded to an even size.
+	if w.written&1 != 0 {
+		if _, err := w.w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+	if w.written == w.dataSize {
+		return nil
+	}
+
+	s, ok := w.w.(io.WriteSeeker)
+	if !ok {
+		if w.dataSize == UnknownSize {
+			return nil
+		}
+		return fmt.Errorf("wav: %d bytes of PCM are written but the header has %d bytes", w.written, w.dataSize)
+	}
+	end, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	start := end - HeaderSize - w.written - w.written&1
+	h, err := Header(w.sampleRate, w.channels, w.written)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := s.Write(h); err != nil {
+		return err
+	}
+	if _, err := s.Seek(end, io.SeekStart); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wav_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/hajimehoshi/go-mp3/wav"
+)
+
+// seekBuffer is an in-memory io.WriteSeeker.
+type seekBuffer struct {
+	buf []byte
+	pos int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if n := b.pos + len(p); n > len(b.buf) {
+		b.buf = append(b.buf, make([]byte, n-len(b.buf))...)
+	}
+	copy(b.buf[b.pos:], p)
+	b.pos += len(p)
+	return len(p), nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += int64(b.pos)
+	case io.SeekEnd:
+		offset += int64(len(b.buf))
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	b.pos = int(offset)
+	return offset, nil
+}
+
+// sizes returns the RIFF chunk size and the data chunk size of the WAV file.
+func sizes(t *testing.T, file []byte) (uint32, uint32) {
+	t.Helper()
+	if len(file) < HeaderSize || string(file[0:4]) != "RIFF" || string(file[8:16]) != "WAVEfmt " || string(file[36:40]) != "data" {
+		t.Fatalf("invalid WAV header: %q", file[:HeaderSize])
+	}
+	return binary.LittleEndian.Uint32(file[4:]), binary.LittleEndian.Uint32(file[40:])
+}
+
+func TestHeader(t *testing.T) {
+	h, err := Header(44100, 2, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if riff, data := sizes(t, h); riff != 1036 || data != 1000 {
+		t.Errorf("sizes: got: %d, %d, want: 1036, 1000", riff, data)
+	}
+	for _, c := range []struct {
+		offset int
+		got    uint32
+		want   uint32
+	}{
+		{20, uint32(binary.LittleEndian.Uint16(h[20:])), 1},
+		{22, uint32(binary.LittleEndian.Uint16(h[22:])), 2},
+		{24, binary.LittleEndian.Uint32(h[24:]), 44100},
+		{28, binary.LittleEndian.Uint32(h[28:]), 44100 * 4},
+		{32, uint32(binary.LittleEndian.Uint16(h[32:])), 4},
+		{34, uint32(binary.LittleEndian.Uint16(h[34:])), 16},
+	} {
+		if c.got != c.want {
+			t.Errorf("offset %d: got: %d, want: %d", c.offset, c.got, c.want)
+		}
+	}
+
+	h, err = Header(8000, 1, UnknownSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if riff, data := sizes(t, h); riff != 0xffffffff || data != 0xffffffff {
+		t.Errorf("sizes with UnknownSize: got: %d, %d, want: 0xffffffff, 0xffffffff", riff, data)
+	}
+
+	for _, c := range []struct {
+		sampleRate int
+		channels   int
+		dataSize   int64
+	}{
+		{0, 2, 0},
+		{44100, 0, 0},
+		{44100, 2, -2},
+		{44100, 2, 1 << 32},
+	} {
+		if _, err := Header(c.sampleRate, c.channels, c.dataSize); err == nil {
+			t.Errorf("Header(%d, %d, %d) must return an error", c.sampleRate, c.channels, c.dataSize)
+		}
+	}
+}
+
+func TestWriterUpdatesHeader(t *testing.T) {
+	pcm := []byte{1, 2, 3, 4, 5}
+
+	b := &seekBuffer{}
+	w, err := NewWriter(b, 44100, 2, UnknownSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(pcm); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// The data chunk is padded to an even size.
+	if got, want := len(b.buf), HeaderSize+len(pcm)+1; got != want {
+		t.Fatalf("file size: got: %d, want: %d", got, want)
+	}
+	if riff, data := sizes(t, b.buf); riff != 42 || data != 5 {
+		t.Errorf("sizes: got: %d, %d, want: 42, 5", riff, data)
+	}
+	if !bytes.Equal(b.buf[HeaderSize:HeaderSize+len(pcm)], pcm) {
+		t.Errorf("PCM doesn't match")
+	}
+	if b.pos != len(b.buf) {
+		t.Errorf("position after Close: got: %d, want: %d", b.pos, len(b.buf))
+	}
+}
+
+func TestWriterStream(t *testing.T) {
+	pcm := make([]byte, 100)
+
+	// Hide io.Seeker.
+	b := &bytes.Buffer{}
+	w, err := NewWriter(struct{ io.Writer }{b}, 44100, 2, UnknownSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(pcm); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if riff, data := sizes(t, b.Bytes()); riff != 0xffffffff || data != 0xffffffff {
+		t.Errorf("sizes: got: %d, %d, want: 0xffffffff, 0xffffffff", riff, data)
+	}
+
+	b.Reset()
+	w, err = NewWriter(struct{ io.Writer }{b}, 44100, 2, int64(len(pcm)+4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(pcm); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err == nil {
+		t.Errorf("Close must return an error when the size doesn't match the header")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+	"github.com/hajimehoshi/go-mp3/wav"
+)
+
+func TestConvertToWAV(t *testing.T) {
+	want := decodeAll(t, "example/mpeg2.mp3", nil)
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	for _, seekable := range []bool{true, false} {
+		var r io.Reader = bytes.NewReader(src)
+		if !seekable {
+			r = struct{ io.Reader }{r}
+		}
+		out := &bytes.Buffer{}
+		if err := mp3.ConvertToWAV(r, out); err != nil {
+			t.Fatal(err)
+		}
+		file := out.Bytes()
+		if len(file) < wav.HeaderSize || string(file[:4]) != "RIFF" || string(file[36:40]) != "data" {
+			t.Fatalf("seekable: %v: invalid WAV header", seekable)
+		}
+		if got, want := binary.LittleEndian.Uint32(file[24:]), uint32(22050); got != want {
+			t.Errorf("seekable: %v: sample rate: got: %d, want: %d", seekable, got, want)
+		}
+		wantSize := uint32(len(want))
+		if !seekable {
+			// The size is unknown without scanning the source.
+			wantSize = 0xffffffff
+		}
+		if got := binary.LittleEndian.Uint32(file[40:]); got != wantSize {
+			t.Errorf("seekable: %v: data size: got: %d, want: %d", seekable, got, wantSize)
+		}
+		if !bytes.Equal(file[wav.HeaderSize:], want) {
+			t.Errorf("seekable: %v: PCM doesn't match (%d bytes, want %d bytes)", seekable, len(file)-wav.HeaderSize, len(want))
+		}
+	}
+}
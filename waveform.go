@@ -0,0 +1,159 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3
+
+import (
+	"errors"
+	"io"
+	"math"
+)
+
+// A WaveformBucket is the summary of the samples in a time range for rendering a waveform.
+type WaveformBucket struct {
+	// Min and Max are the minimum and the maximum sample values of both the channels in the range [-1, 1).
+	Min float32
+	Max float32
+
+	// RMS is the root mean square of the sample values of both the channels.
+	RMS float32
+}
+
+// waveformBlockSize is the number of samples summarized together when the length is unknown.
+const waveformBlockSize = 1152
+
+// waveformSum is the running summary of the samples in a bucket.
+type waveformSum struct {
+	min   int
+	max   int
+	sumsq float64
+	n     int64
+}
+
+func (s *waveformSum) add(v int) {
+	if s.n == 0 || v < s.min {
+		s.min = v
+	}
+	if s.n == 0 || v > s.max {
+		s.max = v
+	}
+	s.sumsq += float64(v * v)
+	s.n++
+}
+
+func (s *waveformSum) merge(o *waveformSum) {
+	if o.n == 0 {
+		return
+	}
+	if s.n == 0 || o.min < s.min {
+		s.min = o.min
+	}
+	if s.n == 0 || o.max > s.max {
+		s.max = o.max
+	}
+	s.sumsq += o.sumsq
+	s.n += o.n
+}
+
+func (s *waveformSum) bucket() WaveformBucket {
+	if s.n == 0 {
+		return WaveformBucket{}
+	}
+	return WaveformBucket{
+		Min: float32(s.min) / 32768,
+		Max: float32(s.max) / 32768,
+		RMS: float32(math.Sqrt(s.sumsq/float64(s.n)) / 32768),
+	}
+}
+
+// Waveform decodes the rest of the stream from the current position and returns the summaries of the samples in
+// the given number of buckets of the same duration, e.g. 100 buckets for a small waveform view.
+//
+// For faster rendering, the decoder can be created with QualityFast, which doesn't change the waveform much for
+// a view.
+//
+// When the stream is not scanned, the buckets are based on the length estimated by the Xing or Info header, and
+// the samples beyond the estimation are in the last bucket. Without the estimation, the samples are summarized in
+// blocks of 1152 samples, and then the blocks are put into the buckets.
+func (d *Decoder) Waveform(buckets int) ([]WaveformBucket, error) {
+	if buckets <= 0 {
+		return nil, errors.New("mp3: the number of buckets must be positive")
+	}
+
+	var total int64 = invalidLength
+	if l := d.Length(); l != invalidLength {
+		total = (l - d.outputPosition(d.pos)) / 4
+	}
+
+	var sums []waveformSum
+	// b is the current bucket, and next is the first sample of the next bucket.
+	var b int
+	var next int64
+	if total != invalidLength {
+		sums = make([]waveformSum, buckets)
+		next = (total + int64(buckets) - 1) / int64(buckets)
+	}
+
+	buf := make([]byte, writeToBufferSize)
+	var pos int64
+	var rest int
+	for {
+		n, err := d.read(buf[rest:])
+		n += rest
+		aligned := n &^ 3
+		for i := 0; i < aligned; i += 4 {
+			var s *waveformSum
+			if total != invalidLength {
+				for pos >= next && b < buckets-1 {
+					b++
+					next = (int64(b+1)*total + int64(buckets) - 1) / int64(buckets)
+				}
+				s = &sums[b]
+			} else {
+				if pos%waveformBlockSize == 0 {
+					sums = append(sums, waveformSum{})
+				}
+				s = &sums[len(sums)-1]
+			}
+			s.add(int(int16(uint16(buf[i]) | uint16(buf[i+1])<<8)))
+			s.add(int(int16(uint16(buf[i+2]) | uint16(buf[i+3])<<8)))
+			pos++
+		}
+		rest = copy(buf, buf[aligned:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if total == invalidLength {
+		blocks := sums
+		sums = make([]waveformSum, buckets)
+		for i := range blocks {
+			b := int64(i) * waveformBlockSize * int64(buckets) / pos
+			sums[b].merge(&blocks[i])
+		}
+	}
+
+	result := make([]WaveformBucket, buckets)
+	for i := range sums {
+		result[i] = sums[i].bucket()
+	}
+	return result, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The go-mp3 Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !mp3minimal
+// +build !mp3minimal
+
+package mp3_test
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// waveform returns the buckets of pcm where the sample i is in the bucket bucketOf(i).
+func waveform(pcm []byte, buckets int, bucketOf func(i int) int) []mp3.WaveformBucket {
+	type sum struct {
+		min, max int16
+		sumsq    float64
+		n        int
+	}
+	sums := make([]sum, buckets)
+	for i := 0; i < len(pcm)/4; i++ {
+		s := &sums[bucketOf(i)]
+		for ch := 0; ch < 2; ch++ {
+			v := int16(uint16(pcm[4*i+2*ch]) | uint16(pcm[4*i+2*ch+1])<<8)
+			if s.n == 0 || v < s.min {
+				s.min = v
+			}
+			if s.n == 0 || v > s.max {
+				s.max = v
+			}
+			s.sumsq += float64(v) * float64(v)
+			s.n++
+		}
+	}
+	result := make([]mp3.WaveformBucket, buckets)
+	for i, s := range sums {
+		result[i] = mp3.WaveformBucket{
+			Min: float32(s.min) / 32768,
+			Max: float32(s.max) / 32768,
+			RMS: float32(math.Sqrt(s.sumsq/float64(s.n)) / 32768),
+		}
+	}
+	return result
+}
+
+func equalWaveforms(a, b []mp3.WaveformBucket) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Min != b[i].Min || a[i].Max != b[i].Max || math.Abs(float64(a[i].RMS-b[i].RMS)) > 1e-6 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestWaveform(t *testing.T) {
+	const buckets = 100
+	pcm := decodeAll(t, "example/mpeg2.mp3", nil)
+	total := len(pcm) / 4
+	src := mustReadFile(t, "example/mpeg2.mp3")
+
+	d, err := mp3.NewDecoder(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.Waveform(buckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := waveform(pcm, buckets, func(i int) int {
+		return i * buckets / total
+	})
+	if !equalWaveforms(got, want) {
+		t.Errorf("Waveform doesn't match")
+	}
+
+	// Without the length, the samples are put into the buckets by blocks.
+	d, err = mp3.NewDecoder(struct{ io.Reader }{bytes.NewReader(src)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = d.Waveform(buckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = waveform(pcm, buckets, func(i int) int {
+		return i / 1152 * 1152 * buckets / total
+	})
+	if !equalWaveforms(got, want) {
+		t.Errorf("Waveform without the length doesn't match")
+	}
+
+	// QualityFast gives a similar waveform.
+	d, err = mp3.NewDecoderWithOptions(bytes.NewReader(src), &mp3.DecoderOptions{
+		Quality: mp3.QualityFast,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := d.Waveform(buckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range fast {
+		if math.Abs(float64(fast[i].RMS-got[i].RMS)) > 0.05 {
+			t.Errorf("bucket %d: RMS with QualityFast: got: %f, want: %f", i, fast[i].RMS, got[i].RMS)
+		}
+	}
+
+	if _, err := d.Waveform(0); err == nil {
+		t.Errorf("Waveform(0) must fail")
+	}
+}